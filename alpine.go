@@ -0,0 +1,226 @@
+package ht
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// Alpine.js directives, as documented at https://alpinejs.dev/directives.
+// Helpers that take JavaScript format it with JS, so Go values can be passed
+// in as args.
+
+// X sets any x-<directive>, for directives and modifiers without a helper.
+func X(directive, v string) html.Attribute { return Attr("x-"+directive, v) }
+
+// XData declares a component whose state is v, usually a struct or map,
+// encoded as a JavaScript object. Use json tags to name the fields. A nil v
+// declares a component without state.
+func XData(v any) html.Attribute {
+	if v == nil {
+		return Attr("x-data", "")
+	}
+	return Attr("x-data", JS("%v", v))
+}
+
+// XDataJS declares a component from a JavaScript expression, for state with
+// methods or getters, or a component registered with Alpine.data().
+func XDataJS(v string, args ...any) html.Attribute { return Attr("x-data", JS(v, args...)) }
+
+// XInit runs the expression when the component is initialized.
+func XInit(v string, args ...any) html.Attribute { return Attr("x-init", JS(v, args...)) }
+
+// XShow shows or hides the element as the expression changes.
+func XShow(v string, args ...any) html.Attribute { return Attr("x-show", JS(v, args...)) }
+
+// XBind sets the attribute to the value of the expression, setting :<attr>.
+func XBind(attr, v string, args ...any) html.Attribute { return Attr(":"+attr, JS(v, args...)) }
+
+// XOn handles the event with the expression, setting @<event>. Use XEvent to
+// add modifiers.
+func XOn(event, v string, args ...any) html.Attribute { return Attr("@"+event, JS(v, args...)) }
+
+// XText sets the element's text to the value of the expression.
+func XText(v string, args ...any) html.Attribute { return Attr("x-text", JS(v, args...)) }
+
+// XHtml sets the element's HTML to the value of the expression. Only use it
+// with trusted content.
+func XHtml(v string, args ...any) html.Attribute { return Attr("x-html", JS(v, args...)) }
+
+// XModelable exposes the component property named v to x-model on the
+// component's element.
+func XModelable(v string) html.Attribute { return Attr("x-modelable", v) }
+
+// XFor repeats a Template for each item, e.g. XFor("item in items").
+func XFor(v string) html.Attribute { return Attr("x-for", v) }
+
+// XIf adds the content of a Template when the expression is true.
+func XIf(v string, args ...any) html.Attribute { return Attr("x-if", JS(v, args...)) }
+
+// XEffect runs the expression again whenever the data it uses changes.
+func XEffect(v string, args ...any) html.Attribute { return Attr("x-effect", JS(v, args...)) }
+
+// XIgnore stops Alpine from initializing the element's children.
+func XIgnore() html.Attribute { return Attr("x-ignore", "") }
+
+// XRef makes the element available as $refs.<name>.
+func XRef(name string) html.Attribute { return Attr("x-ref", name) }
+
+// XCloak hides the element until Alpine has initialized it, together with
+// the CSS rule [x-cloak] { display: none !important; }.
+func XCloak() html.Attribute { return Attr("x-cloak", "") }
+
+// XTeleport moves the content of a Template to the element the selector
+// matches.
+func XTeleport(selector string) html.Attribute { return Attr("x-teleport", selector) }
+
+// XId scopes the $id() names to the element, so each copy of a component gets
+// its own ids.
+func XId(names ...string) html.Attribute {
+	return Attr("x-id", JS("%v", append([]string{}, names...)))
+}
+
+// XTransition animates the element as XShow shows and hides it. mods are
+// joined with dots, e.g. XTransition("opacity", "duration.500ms").
+func XTransition(mods ...string) html.Attribute {
+	return Attr(strings.Join(append([]string{"x-transition"}, mods...), "."), "")
+}
+
+// XTransitionClasses sets the classes for a stage of the transition: "enter",
+// "enter-start", "enter-end", "leave", "leave-start" or "leave-end".
+func XTransitionClasses(stage, classes string) html.Attribute {
+	return Attr("x-transition:"+stage, classes)
+}
+
+// Plugin directives. Each needs its plugin loaded, as described at
+// https://alpinejs.dev/plugins.
+
+// XIntersect runs the expression when the element enters the viewport.
+func XIntersect(v string, args ...any) html.Attribute { return Attr("x-intersect", JS(v, args...)) }
+
+// XIntersectLeave runs the expression when the element leaves the viewport.
+func XIntersectLeave(v string, args ...any) html.Attribute {
+	return Attr("x-intersect:leave", JS(v, args...))
+}
+
+// XCollapse animates the height of the element as XShow shows and hides it.
+func XCollapse() html.Attribute { return Attr("x-collapse", "") }
+
+// XTrap traps focus inside the element while the expression is true.
+func XTrap(v string, args ...any) html.Attribute { return Attr("x-trap", JS(v, args...)) }
+
+// XMask formats input as it is typed, e.g. XMask("99/99/9999").
+func XMask(pattern string) html.Attribute { return Attr("x-mask", pattern) }
+
+// XMaskDynamic computes the mask from the expression, which receives the
+// input as $input.
+func XMaskDynamic(v string, args ...any) html.Attribute {
+	return Attr("x-mask:dynamic", JS(v, args...))
+}
+
+// AlpineEvent is an event handler with modifiers, built with XEvent. Each
+// method returns a new AlpineEvent, and an AlpineEvent can be passed to
+// Element as is:
+//
+//	Div(XEvent("click", "open = false").Outside(), ...)
+//
+// See https://alpinejs.dev/directives/on.
+type AlpineEvent struct {
+	event string
+	expr  string
+	mods  []string
+}
+
+// XEvent handles the event with the expression, like XOn, but returns an
+// AlpineEvent to add modifiers to.
+func XEvent(event, v string, args ...any) AlpineEvent {
+	return AlpineEvent{event: event, expr: JS(v, args...)}
+}
+
+func (e AlpineEvent) Prevent() AlpineEvent  { return e.with("prevent") }
+func (e AlpineEvent) Stop() AlpineEvent     { return e.with("stop") }
+func (e AlpineEvent) Outside() AlpineEvent  { return e.with("outside") }
+func (e AlpineEvent) Window() AlpineEvent   { return e.with("window") }
+func (e AlpineEvent) Document() AlpineEvent { return e.with("document") }
+func (e AlpineEvent) Once() AlpineEvent     { return e.with("once") }
+func (e AlpineEvent) Self() AlpineEvent     { return e.with("self") }
+func (e AlpineEvent) Camel() AlpineEvent    { return e.with("camel") }
+func (e AlpineEvent) Dot() AlpineEvent      { return e.with("dot") }
+func (e AlpineEvent) Passive() AlpineEvent  { return e.with("passive") }
+func (e AlpineEvent) Capture() AlpineEvent  { return e.with("capture") }
+
+// Key only handles keyboard events for the key, in kebab case, e.g. "enter",
+// "escape", "shift" or "page-down". Chain it for combinations.
+func (e AlpineEvent) Key(name string) AlpineEvent { return e.with(name) }
+
+// Debounce waits until no event has arrived for d before handling the last
+// one. A zero d uses Alpine's default of 250ms.
+func (e AlpineEvent) Debounce(d time.Duration) AlpineEvent {
+	return e.with(alpineWait("debounce", d))
+}
+
+// Throttle handles at most one event every d. A zero d uses Alpine's
+// default of 250ms.
+func (e AlpineEvent) Throttle(d time.Duration) AlpineEvent {
+	return e.with(alpineWait("throttle", d))
+}
+
+func (e AlpineEvent) Attribute() html.Attribute {
+	return Attr(strings.Join(append([]string{"@" + e.event}, e.mods...), "."), e.expr)
+}
+
+func (e AlpineEvent) with(mod string) AlpineEvent {
+	e.mods = append(slices.Clip(e.mods), mod)
+	return e
+}
+
+// AlpineModel is a two-way binding with modifiers, built with XModel. Like
+// AlpineEvent, each method returns a new AlpineModel, and an AlpineModel can
+// be passed to Element as is.
+//
+// See https://alpinejs.dev/directives/model.
+type AlpineModel struct {
+	prop string
+	mods []string
+}
+
+// XModel binds the value of an input, select or textarea to the property.
+func XModel(prop string) AlpineModel { return AlpineModel{prop: prop} }
+
+func (m AlpineModel) Lazy() AlpineModel    { return m.with("lazy") }
+func (m AlpineModel) Number() AlpineModel  { return m.with("number") }
+func (m AlpineModel) Boolean() AlpineModel { return m.with("boolean") }
+func (m AlpineModel) Fill() AlpineModel    { return m.with("fill") }
+
+// Debounce updates the property only once input has stopped for d. A zero d
+// uses Alpine's default of 250ms.
+func (m AlpineModel) Debounce(d time.Duration) AlpineModel {
+	return m.with(alpineWait("debounce", d))
+}
+
+// Throttle updates the property at most once every d. A zero d uses Alpine's
+// default of 250ms.
+func (m AlpineModel) Throttle(d time.Duration) AlpineModel {
+	return m.with(alpineWait("throttle", d))
+}
+
+func (m AlpineModel) Attribute() html.Attribute {
+	return Attr(strings.Join(append([]string{"x-model"}, m.mods...), "."), m.prop)
+}
+
+func (m AlpineModel) with(mod string) AlpineModel {
+	m.mods = append(slices.Clip(m.mods), mod)
+	return m
+}
+
+// alpineWait formats a debounce or throttle modifier, which Alpine reads in
+// milliseconds.
+func alpineWait(mod string, d time.Duration) string {
+	if d <= 0 {
+		return mod
+	}
+	return mod + "." + strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
@@ -0,0 +1,38 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAlpine(t *testing.T) {
+	type state struct {
+		Open  bool     `json:"open"`
+		Items []string `json:"items"`
+	}
+	for _, tt := range []struct {
+		attr any
+		want string
+	}{
+		{XData(state{Items: []string{"</script>"}}), `x-data="{&#34;open&#34;:false,&#34;items&#34;:[&#34;\u003c/script\u003e&#34;]}"`},
+		{XData(nil), `x-data=""`},
+		{XShow("tab === %v", "a'b"), `x-show="tab === &#34;a&#39;b&#34;"`},
+		{XEvent("click", "open = false").Outside().Window(), `@click.outside.window="open = false"`},
+		{XEvent("keyup", "search()").Key("enter").Debounce(300 * time.Millisecond), `@keyup.enter.debounce.300ms="search()"`},
+		{XModel("count").Number().Debounce(0), `x-model.number.debounce="count"`},
+		{XId("tab", "panel"), `x-id="[&#34;tab&#34;,&#34;panel&#34;]"`},
+		{XId(), `x-id="[]"`},
+		{XTransition("opacity", "duration.500ms"), `x-transition.opacity.duration.500ms=""`},
+		{XMask("99/99/9999"), `x-mask="99/99/9999"`},
+	} {
+		var b strings.Builder
+		if err := Render(context.Background(), &b, Div(tt.attr)); err != nil {
+			t.Fatal(err)
+		}
+		if want := "<div " + tt.want + "></div>"; stripSources(b.String()) != want {
+			t.Errorf("got %s, want %s", stripSources(b.String()), want)
+		}
+	}
+}
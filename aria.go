@@ -0,0 +1,421 @@
+package ht
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// AriaRole is a WAI-ARIA 1.2 role, for the role attribute. Abstract roles,
+// which authors must not use, are left out.
+type AriaRole string
+
+const (
+	RoleAlert            AriaRole = "alert"
+	RoleAlertdialog      AriaRole = "alertdialog"
+	RoleApplication      AriaRole = "application"
+	RoleArticle          AriaRole = "article"
+	RoleBanner           AriaRole = "banner"
+	RoleBlockquote       AriaRole = "blockquote"
+	RoleButton           AriaRole = "button"
+	RoleCaption          AriaRole = "caption"
+	RoleCell             AriaRole = "cell"
+	RoleCheckbox         AriaRole = "checkbox"
+	RoleCode             AriaRole = "code"
+	RoleColumnheader     AriaRole = "columnheader"
+	RoleCombobox         AriaRole = "combobox"
+	RoleComplementary    AriaRole = "complementary"
+	RoleContentinfo      AriaRole = "contentinfo"
+	RoleDefinition       AriaRole = "definition"
+	RoleDeletion         AriaRole = "deletion"
+	RoleDialog           AriaRole = "dialog"
+	RoleDocument         AriaRole = "document"
+	RoleEmphasis         AriaRole = "emphasis"
+	RoleFeed             AriaRole = "feed"
+	RoleFigure           AriaRole = "figure"
+	RoleForm             AriaRole = "form"
+	RoleGeneric          AriaRole = "generic"
+	RoleGrid             AriaRole = "grid"
+	RoleGridcell         AriaRole = "gridcell"
+	RoleGroup            AriaRole = "group"
+	RoleHeading          AriaRole = "heading"
+	RoleImg              AriaRole = "img"
+	RoleInsertion        AriaRole = "insertion"
+	RoleLink             AriaRole = "link"
+	RoleList             AriaRole = "list"
+	RoleListbox          AriaRole = "listbox"
+	RoleListitem         AriaRole = "listitem"
+	RoleLog              AriaRole = "log"
+	RoleMain             AriaRole = "main"
+	RoleMarquee          AriaRole = "marquee"
+	RoleMath             AriaRole = "math"
+	RoleMenu             AriaRole = "menu"
+	RoleMenubar          AriaRole = "menubar"
+	RoleMenuitem         AriaRole = "menuitem"
+	RoleMenuitemcheckbox AriaRole = "menuitemcheckbox"
+	RoleMenuitemradio    AriaRole = "menuitemradio"
+	RoleMeter            AriaRole = "meter"
+	RoleNavigation       AriaRole = "navigation"
+	RoleNone             AriaRole = "none"
+	RoleNote             AriaRole = "note"
+	RoleOption           AriaRole = "option"
+	RoleParagraph        AriaRole = "paragraph"
+	RolePresentation     AriaRole = "presentation"
+	RoleProgressbar      AriaRole = "progressbar"
+	RoleRadio            AriaRole = "radio"
+	RoleRadiogroup       AriaRole = "radiogroup"
+	RoleRegion           AriaRole = "region"
+	RoleRow              AriaRole = "row"
+	RoleRowgroup         AriaRole = "rowgroup"
+	RoleRowheader        AriaRole = "rowheader"
+	RoleScrollbar        AriaRole = "scrollbar"
+	RoleSearch           AriaRole = "search"
+	RoleSearchbox        AriaRole = "searchbox"
+	RoleSeparator        AriaRole = "separator"
+	RoleSlider           AriaRole = "slider"
+	RoleSpinbutton       AriaRole = "spinbutton"
+	RoleStatus           AriaRole = "status"
+	RoleStrong           AriaRole = "strong"
+	RoleSubscript        AriaRole = "subscript"
+	RoleSuperscript      AriaRole = "superscript"
+	RoleSwitch           AriaRole = "switch"
+	RoleTab              AriaRole = "tab"
+	RoleTable            AriaRole = "table"
+	RoleTablist          AriaRole = "tablist"
+	RoleTabpanel         AriaRole = "tabpanel"
+	RoleTerm             AriaRole = "term"
+	RoleTextbox          AriaRole = "textbox"
+	RoleTime             AriaRole = "time"
+	RoleTimer            AriaRole = "timer"
+	RoleToolbar          AriaRole = "toolbar"
+	RoleTooltip          AriaRole = "tooltip"
+	RoleTree             AriaRole = "tree"
+	RoleTreegrid         AriaRole = "treegrid"
+	RoleTreeitem         AriaRole = "treeitem"
+)
+
+func (r AriaRole) Attribute() html.Attribute { return Attr("role", string(r)) }
+
+// Tristate is the value of aria-checked and aria-pressed.
+type Tristate string
+
+const (
+	TristateFalse Tristate = "false"
+	TristateTrue  Tristate = "true"
+	TristateMixed Tristate = "mixed"
+)
+
+// Tri returns TristateTrue or TristateFalse.
+func Tri(on bool) Tristate { return Tristate(strconv.FormatBool(on)) }
+
+// ARIA states and properties. Bool values are written as "true" or "false",
+// and IDs lists are joined with spaces.
+
+func AriaActivedescendant(id string) html.Attribute { return Aria("activedescendant", id) }
+func AriaAtomic(on bool) html.Attribute             { return Aria("atomic", strconv.FormatBool(on)) }
+func AriaAutocomplete(v string) html.Attribute      { return Aria("autocomplete", v) }
+func AriaBusy(on bool) html.Attribute               { return Aria("busy", strconv.FormatBool(on)) }
+func AriaChecked(v Tristate) html.Attribute         { return Aria("checked", string(v)) }
+func AriaColcount(n int) html.Attribute             { return Aria("colcount", strconv.Itoa(n)) }
+func AriaColindex(n int) html.Attribute             { return Aria("colindex", strconv.Itoa(n)) }
+func AriaColspan(n int) html.Attribute              { return Aria("colspan", strconv.Itoa(n)) }
+func AriaControls(ids ...string) html.Attribute     { return Aria("controls", strings.Join(ids, " ")) }
+func AriaCurrent(v string) html.Attribute           { return Aria("current", v) }
+func AriaDescribedby(ids ...string) html.Attribute {
+	return Aria("describedby", strings.Join(ids, " "))
+}
+func AriaDescription(v string) html.Attribute  { return Aria("description", v) }
+func AriaDetails(ids ...string) html.Attribute { return Aria("details", strings.Join(ids, " ")) }
+func AriaDisabled(on bool) html.Attribute      { return Aria("disabled", strconv.FormatBool(on)) }
+func AriaErrormessage(ids ...string) html.Attribute {
+	return Aria("errormessage", strings.Join(ids, " "))
+}
+func AriaExpanded(on bool) html.Attribute         { return Aria("expanded", strconv.FormatBool(on)) }
+func AriaFlowto(ids ...string) html.Attribute     { return Aria("flowto", strings.Join(ids, " ")) }
+func AriaHaspopup(v string) html.Attribute        { return Aria("haspopup", v) }
+func AriaHidden(on bool) html.Attribute           { return Aria("hidden", strconv.FormatBool(on)) }
+func AriaInvalid(v string) html.Attribute         { return Aria("invalid", v) }
+func AriaKeyshortcuts(v string) html.Attribute    { return Aria("keyshortcuts", v) }
+func AriaLabel(v string) html.Attribute           { return Aria("label", v) }
+func AriaLabelledby(ids ...string) html.Attribute { return Aria("labelledby", strings.Join(ids, " ")) }
+func AriaLevel(n int) html.Attribute              { return Aria("level", strconv.Itoa(n)) }
+func AriaLive(v string) html.Attribute            { return Aria("live", v) }
+func AriaModal(on bool) html.Attribute            { return Aria("modal", strconv.FormatBool(on)) }
+func AriaMultiline(on bool) html.Attribute        { return Aria("multiline", strconv.FormatBool(on)) }
+func AriaMultiselectable(on bool) html.Attribute {
+	return Aria("multiselectable", strconv.FormatBool(on))
+}
+func AriaOrientation(v string) html.Attribute     { return Aria("orientation", v) }
+func AriaOwns(ids ...string) html.Attribute       { return Aria("owns", strings.Join(ids, " ")) }
+func AriaPlaceholder(v string) html.Attribute     { return Aria("placeholder", v) }
+func AriaPosinset(n int) html.Attribute           { return Aria("posinset", strconv.Itoa(n)) }
+func AriaPressed(v Tristate) html.Attribute       { return Aria("pressed", string(v)) }
+func AriaReadonly(on bool) html.Attribute         { return Aria("readonly", strconv.FormatBool(on)) }
+func AriaRelevant(v string) html.Attribute        { return Aria("relevant", v) }
+func AriaRequired(on bool) html.Attribute         { return Aria("required", strconv.FormatBool(on)) }
+func AriaRoledescription(v string) html.Attribute { return Aria("roledescription", v) }
+func AriaRowcount(n int) html.Attribute           { return Aria("rowcount", strconv.Itoa(n)) }
+func AriaRowindex(n int) html.Attribute           { return Aria("rowindex", strconv.Itoa(n)) }
+func AriaRowspan(n int) html.Attribute            { return Aria("rowspan", strconv.Itoa(n)) }
+func AriaSelected(on bool) html.Attribute         { return Aria("selected", strconv.FormatBool(on)) }
+func AriaSetsize(n int) html.Attribute            { return Aria("setsize", strconv.Itoa(n)) }
+func AriaSort(v string) html.Attribute            { return Aria("sort", v) }
+func AriaValuemax(v float64) html.Attribute       { return Aria("valuemax", formatFloat(v)) }
+func AriaValuemin(v float64) html.Attribute       { return Aria("valuemin", formatFloat(v)) }
+func AriaValuenow(v float64) html.Attribute       { return Aria("valuenow", formatFloat(v)) }
+func AriaValuetext(v string) html.Attribute       { return Aria("valuetext", v) }
+
+// CheckARIA reports ARIA mistakes in the tree rooted at node: unknown roles,
+// unknown aria-* attributes, and attributes the element's role does not
+// support or prohibits. The role is the first known token of the role
+// attribute, or else the element's implicit role, such as checkbox for
+// Input(InputCheckbox); elements without either are not checked. It returns
+// every problem found, combined with errors.Join.
+//
+// Like CheckLimits, it is meant for tests and development, not for every
+// request.
+func CheckARIA(node *html.Node) error {
+	var errs []error
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Namespace == "" {
+			errs = append(errs, checkARIA(n)...)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(node)
+	return errors.Join(errs...)
+}
+
+// checkARIA checks the ARIA attributes of a single element.
+func checkARIA(n *html.Node) []error {
+	var errs []error
+	role, explicit := implicitRole(n), false
+	if v, ok := lookupAttr(n, "", "role"); ok {
+		tokens := strings.Fields(v)
+		for _, t := range tokens {
+			if _, known := roleAttrs[AriaRole(t)]; known {
+				role, explicit = AriaRole(t), true
+				break
+			}
+		}
+		if !explicit && len(tokens) > 0 {
+			errs = append(errs, fmt.Errorf("ht: <%s> has unknown ARIA role %q", n.Data, v))
+		}
+	}
+
+	for _, attr := range n.Attr {
+		name, ok := strings.CutPrefix(attr.Key, "aria-")
+		if !ok || attr.Namespace != "" {
+			continue
+		}
+		switch {
+		case !ariaAttrs[name]:
+			errs = append(errs, fmt.Errorf("ht: <%s> has unknown ARIA attribute %q", n.Data, attr.Key))
+		case role == "":
+		case (name == "label" || name == "labelledby") && namingProhibited[role]:
+			errs = append(errs, fmt.Errorf("ht: %s is prohibited on <%s> with role %s", attr.Key, n.Data, role))
+		case !globalAriaAttrs[name] && !slices.Contains(roleAttrs[role], name):
+			errs = append(errs, fmt.Errorf("ht: %s is not supported on <%s> with role %s", attr.Key, n.Data, role))
+		}
+	}
+	return errs
+}
+
+// implicitRole returns the role an HTML element has without a role attribute,
+// following ARIA in HTML, or "" for elements it does not check.
+func implicitRole(n *html.Node) AriaRole {
+	_, hasHref := lookupAttr(n, "", "href")
+	switch n.Data {
+	case "a", "area":
+		if hasHref {
+			return RoleLink
+		}
+		return RoleGeneric
+	case "img":
+		if alt, ok := lookupAttr(n, "", "alt"); ok && alt == "" {
+			return RolePresentation
+		}
+		return RoleImg
+	case "input":
+		t, _ := lookupAttr(n, "", "type")
+		_, list := lookupAttr(n, "", "list")
+		switch strings.ToLower(t) {
+		case "button", "image", "reset", "submit":
+			return RoleButton
+		case "checkbox":
+			return RoleCheckbox
+		case "radio":
+			return RoleRadio
+		case "range":
+			return RoleSlider
+		case "number":
+			return RoleSpinbutton
+		case "search":
+			if list {
+				return RoleCombobox
+			}
+			return RoleSearchbox
+		case "", "text", "email", "tel", "url":
+			if list {
+				return RoleCombobox
+			}
+			return RoleTextbox
+		}
+		return ""
+	case "select":
+		_, multiple := lookupAttr(n, "", "multiple")
+		size, _ := lookupAttr(n, "", "size")
+		if s, _ := strconv.Atoi(size); multiple || s > 1 {
+			return RoleListbox
+		}
+		return RoleCombobox
+	}
+	return implicitRoles[n.Data]
+}
+
+// implicitRoles are the implicit roles that do not depend on attributes.
+var implicitRoles = map[string]AriaRole{
+	"article": RoleArticle, "aside": RoleComplementary, "b": RoleGeneric,
+	"blockquote": RoleBlockquote, "button": RoleButton, "caption": RoleCaption,
+	"code": RoleCode, "del": RoleDeletion, "details": RoleGroup,
+	"dialog": RoleDialog, "div": RoleGeneric, "em": RoleEmphasis,
+	"fieldset": RoleGroup, "figure": RoleFigure, "form": RoleForm,
+	"h1": RoleHeading, "h2": RoleHeading, "h3": RoleHeading,
+	"h4": RoleHeading, "h5": RoleHeading, "h6": RoleHeading,
+	"hr": RoleSeparator, "i": RoleGeneric, "ins": RoleInsertion,
+	"li": RoleListitem, "main": RoleMain, "menu": RoleList,
+	"meter": RoleMeter, "nav": RoleNavigation, "ol": RoleList,
+	"option": RoleOption, "output": RoleStatus, "p": RoleParagraph,
+	"progress": RoleProgressbar, "s": RoleDeletion, "search": RoleSearch,
+	"span": RoleGeneric, "strong": RoleStrong, "sub": RoleSubscript,
+	"sup": RoleSuperscript, "table": RoleTable, "tbody": RoleRowgroup,
+	"td": RoleCell, "textarea": RoleTextbox, "tfoot": RoleRowgroup,
+	"th": RoleColumnheader, "thead": RoleRowgroup, "time": RoleTime,
+	"tr": RoleRow, "u": RoleGeneric, "ul": RoleList,
+}
+
+// globalAriaAttrs are the states and properties every role supports.
+var globalAriaAttrs = map[string]bool{
+	"atomic": true, "braillelabel": true, "brailleroledescription": true,
+	"busy": true, "controls": true, "current": true, "describedby": true,
+	"description": true, "details": true, "disabled": true,
+	"dropeffect": true, "errormessage": true, "flowto": true,
+	"grabbed": true, "haspopup": true, "hidden": true, "invalid": true,
+	"keyshortcuts": true, "label": true, "labelledby": true, "live": true,
+	"owns": true, "relevant": true, "roledescription": true,
+}
+
+// ariaAttrs are all WAI-ARIA 1.2 states and properties.
+var ariaAttrs = func() map[string]bool {
+	m := make(map[string]bool)
+	for name := range globalAriaAttrs {
+		m[name] = true
+	}
+	for _, names := range roleAttrs {
+		for _, name := range names {
+			m[name] = true
+		}
+	}
+	return m
+}()
+
+// namingProhibited are the roles that cannot be named by authors.
+var namingProhibited = map[AriaRole]bool{
+	RoleCaption: true, RoleCode: true, RoleDeletion: true, RoleEmphasis: true,
+	RoleGeneric: true, RoleInsertion: true, RoleNone: true, RoleParagraph: true,
+	RolePresentation: true, RoleStrong: true, RoleSubscript: true,
+	RoleSuperscript: true, RoleTime: true,
+}
+
+// roleAttrs are the states and properties each role supports in addition to
+// the global ones, including those it inherits.
+var roleAttrs = map[AriaRole][]string{
+	RoleAlert:            nil,
+	RoleAlertdialog:      {"modal"},
+	RoleApplication:      {"activedescendant", "expanded"},
+	RoleArticle:          {"posinset", "setsize"},
+	RoleBanner:           nil,
+	RoleBlockquote:       nil,
+	RoleButton:           {"expanded", "pressed"},
+	RoleCaption:          nil,
+	RoleCell:             {"colindex", "colspan", "rowindex", "rowspan"},
+	RoleCheckbox:         {"checked", "expanded", "readonly", "required"},
+	RoleCode:             nil,
+	RoleColumnheader:     {"colindex", "colspan", "expanded", "readonly", "required", "rowindex", "rowspan", "selected", "sort"},
+	RoleCombobox:         {"activedescendant", "autocomplete", "expanded", "readonly", "required"},
+	RoleComplementary:    nil,
+	RoleContentinfo:      nil,
+	RoleDefinition:       nil,
+	RoleDeletion:         nil,
+	RoleDialog:           {"modal"},
+	RoleDocument:         nil,
+	RoleEmphasis:         nil,
+	RoleFeed:             nil,
+	RoleFigure:           nil,
+	RoleForm:             nil,
+	RoleGeneric:          nil,
+	RoleGrid:             {"activedescendant", "colcount", "multiselectable", "readonly", "rowcount"},
+	RoleGridcell:         {"colindex", "colspan", "expanded", "readonly", "required", "rowindex", "rowspan", "selected"},
+	RoleGroup:            {"activedescendant"},
+	RoleHeading:          {"level"},
+	RoleImg:              nil,
+	RoleInsertion:        nil,
+	RoleLink:             {"expanded"},
+	RoleList:             nil,
+	RoleListbox:          {"activedescendant", "expanded", "multiselectable", "orientation", "readonly", "required"},
+	RoleListitem:         {"level", "posinset", "setsize"},
+	RoleLog:              nil,
+	RoleMain:             nil,
+	RoleMarquee:          nil,
+	RoleMath:             nil,
+	RoleMenu:             {"activedescendant", "orientation"},
+	RoleMenubar:          {"activedescendant", "orientation"},
+	RoleMenuitem:         {"expanded", "posinset", "setsize"},
+	RoleMenuitemcheckbox: {"checked", "expanded", "posinset", "setsize"},
+	RoleMenuitemradio:    {"checked", "expanded", "posinset", "setsize"},
+	RoleMeter:            {"valuemax", "valuemin", "valuenow", "valuetext"},
+	RoleNavigation:       nil,
+	RoleNone:             nil,
+	RoleNote:             nil,
+	RoleOption:           {"checked", "posinset", "selected", "setsize"},
+	RoleParagraph:        nil,
+	RolePresentation:     nil,
+	RoleProgressbar:      {"valuemax", "valuemin", "valuenow", "valuetext"},
+	RoleRadio:            {"checked", "posinset", "setsize"},
+	RoleRadiogroup:       {"activedescendant", "readonly", "required"},
+	RoleRegion:           nil,
+	RoleRow:              {"activedescendant", "colindex", "expanded", "level", "posinset", "rowindex", "selected", "setsize"},
+	RoleRowgroup:         nil,
+	RoleRowheader:        {"colindex", "colspan", "expanded", "readonly", "required", "rowindex", "rowspan", "selected", "sort"},
+	RoleScrollbar:        {"orientation", "valuemax", "valuemin", "valuenow", "valuetext"},
+	RoleSearch:           nil,
+	RoleSearchbox:        {"activedescendant", "autocomplete", "multiline", "placeholder", "readonly", "required"},
+	RoleSeparator:        {"orientation", "valuemax", "valuemin", "valuenow", "valuetext"},
+	RoleSlider:           {"orientation", "readonly", "valuemax", "valuemin", "valuenow", "valuetext"},
+	RoleSpinbutton:       {"activedescendant", "readonly", "required", "valuemax", "valuemin", "valuenow", "valuetext"},
+	RoleStatus:           nil,
+	RoleStrong:           nil,
+	RoleSubscript:        nil,
+	RoleSuperscript:      nil,
+	RoleSwitch:           {"checked", "expanded", "readonly", "required"},
+	RoleTab:              {"expanded", "posinset", "selected", "setsize"},
+	RoleTable:            {"colcount", "rowcount"},
+	RoleTablist:          {"activedescendant", "multiselectable", "orientation"},
+	RoleTabpanel:         nil,
+	RoleTerm:             nil,
+	RoleTextbox:          {"activedescendant", "autocomplete", "multiline", "placeholder", "readonly", "required"},
+	RoleTime:             nil,
+	RoleTimer:            nil,
+	RoleToolbar:          {"activedescendant", "orientation"},
+	RoleTooltip:          nil,
+	RoleTree:             {"activedescendant", "multiselectable", "orientation", "required"},
+	RoleTreegrid:         {"activedescendant", "colcount", "multiselectable", "orientation", "readonly", "required", "rowcount"},
+	RoleTreeitem:         {"checked", "expanded", "level", "posinset", "selected", "setsize"},
+}
@@ -0,0 +1,37 @@
+package ht
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckARIA(t *testing.T) {
+	ok := Div(
+		Button(AriaExpanded(false), AriaControls("menu", "help"), AriaPressed(TristateMixed)),
+		Ul(RoleMenu, Id("menu"), Li(RoleMenuitemcheckbox, AriaChecked(Tri(true)))),
+		Input(InputCheckbox, AriaChecked(TristateMixed), AriaDescribedby("hint")),
+		Nav(AriaLabel("Main")),
+		Div(Role("bogus tab"), AriaSelected(true)),
+	)
+	if err := CheckARIA(ok); err != nil {
+		t.Errorf("valid tree: %v", err)
+	}
+
+	bad := Div(
+		Span(AriaLabel("icon")),
+		Div(RoleButton, AriaChecked(TristateTrue)),
+		A(Href("/"), Aria("expandd", "true")),
+		Div(Role("buton")),
+	)
+	err := CheckARIA(bad)
+	for _, want := range []string{
+		"aria-label is prohibited on <span> with role generic",
+		"aria-checked is not supported on <div> with role button",
+		`unknown ARIA attribute "aria-expandd"`,
+		`unknown ARIA role "buton"`,
+	} {
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("missing %q in %v", want, err)
+		}
+	}
+}
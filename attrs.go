@@ -1,86 +1,80 @@
 package ht
 
 import (
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/net/html"
 )
 
 func Attr(k, v string) html.Attribute { return html.Attribute{Key: k, Val: v} }
 
-// common attributes
+// Helpers for individual attributes are generated in attrs_gen.go.
 
-func Action(v string) html.Attribute       { return Attr("action", v) }
-func Alt(v string) html.Attribute          { return Attr("alt", v) }
 func Aria(name, val string) html.Attribute { return Attr("aria-"+name, val) }
-func Autocomplete(v string) html.Attribute { return Attr("autocomplete", v) }
-func Charset(v string) html.Attribute      { return Attr("charset", v) }
 func Class(v ...string) html.Attribute     { return Attr("class", strings.Join(v, " ")) }
 func Content(v ...string) html.Attribute   { return Attr("content", strings.Join(v, ", ")) }
-func Colspan(v string) html.Attribute      { return Attr("colspan", v) }
 func Data(name, val string) html.Attribute { return Attr("data-"+name, val) }
-func Download(v string) html.Attribute     { return Attr("download", v) }
-func Enctype(v string) html.Attribute      { return Attr("enctype", v) }
-func For(v string) html.Attribute          { return Attr("for", v) }
-func Height(v string) html.Attribute       { return Attr("height", v) }
-func Href(v string) html.Attribute         { return Attr("href", v) }
-func Id(v string) html.Attribute           { return Attr("id", v) }
-func LabelAttr(v string) html.Attribute    { return Attr("label", v) }
-func Lang(v string) html.Attribute         { return Attr("lang", v) }
-func Max(v string) html.Attribute          { return Attr("max", v) }
-func Method(v string) html.Attribute       { return Attr("method", v) }
-func Min(v string) html.Attribute          { return Attr("min", v) }
-func Name(v string) html.Attribute         { return Attr("name", v) }
-func Pattern(v string) html.Attribute      { return Attr("pattern", v) }
-func Placeholder(v string) html.Attribute  { return Attr("placeholder", v) }
-func Rel(v string) html.Attribute          { return Attr("rel", v) }
-func Role(v string) html.Attribute         { return Attr("role", v) }
-func Size(v string) html.Attribute         { return Attr("size", v) }
-func Src(v string) html.Attribute          { return Attr("src", v) }
-func Step(v string) html.Attribute         { return Attr("step", v) }
-func StyleAttr(v string) html.Attribute    { return Attr("style", v) }
-func Tabindex(v string) html.Attribute     { return Attr("tabindex", v) }
-func Target(v string) html.Attribute       { return Attr("target", v) }
-func TitleAttr(v string) html.Attribute    { return Attr("title", v) }
-func Type(v string) html.Attribute         { return Attr("type", v) }
-func Value(v string) html.Attribute        { return Attr("value", v) }
-func Width(v string) html.Attribute        { return Attr("width", v) }
 
-// boolean attributes
+// Bool returns the boolean attribute name when on is true, and nil, which
+// Element ignores, when it is false: Input(Bool("checked", done)).
+func Bool(name string, on bool) any { return If(on, Attr(name, "")) }
 
-func Autofocus() html.Attribute { return Attr("autofocus", "") }
-func Checked() html.Attribute   { return Attr("checked", "") }
-func Defer() html.Attribute     { return Attr("defer", "") }
-func Disabled() html.Attribute  { return Attr("disabled", "") }
-func Hidden() html.Attribute    { return Attr("hidden", "") }
-func Multiple() html.Attribute  { return Attr("multiple", "") }
-func Readonly() html.Attribute  { return Attr("readonly", "") }
-func Required() html.Attribute  { return Attr("required", "") }
-func Selected() html.Attribute  { return Attr("selected", "") }
+// numeric and time attributes
 
-// htmx attributes
+func ColspanInt(v int) html.Attribute     { return Colspan(strconv.Itoa(v)) }
+func HeightInt(v int) html.Attribute      { return Height(strconv.Itoa(v)) }
+func MaxInt(v int) html.Attribute         { return Max(strconv.Itoa(v)) }
+func MaxFloat(v float64) html.Attribute   { return Max(formatFloat(v)) }
+func MinInt(v int) html.Attribute         { return Min(strconv.Itoa(v)) }
+func MinFloat(v float64) html.Attribute   { return Min(formatFloat(v)) }
+func SizeInt(v int) html.Attribute        { return Size(strconv.Itoa(v)) }
+func StepFloat(v float64) html.Attribute  { return Step(formatFloat(v)) }
+func TabindexInt(v int) html.Attribute    { return Tabindex(strconv.Itoa(v)) }
+func ValueInt(v int) html.Attribute       { return Value(strconv.Itoa(v)) }
+func ValueFloat(v float64) html.Attribute { return Value(formatFloat(v)) }
+func WidthInt(v int) html.Attribute       { return Width(strconv.Itoa(v)) }
 
-func HxBoost(v string) html.Attribute      { return Attr("hx-boost", v) }
-func HxConfirm(v string) html.Attribute    { return Attr("hx-confirm", v) }
-func HxDelete(v string) html.Attribute     { return Attr("hx-delete", v) }
-func HxGet(v string) html.Attribute        { return Attr("hx-get", v) }
-func HxIndicator(v string) html.Attribute  { return Attr("hx-indicator", v) }
-func HxPost(v string) html.Attribute       { return Attr("hx-post", v) }
-func HxPushUrl(v string) html.Attribute    { return Attr("hx-push-url", v) }
-func HxPut(v string) html.Attribute        { return Attr("hx-put", v) }
-func HxPatch(v string) html.Attribute      { return Attr("hx-patch", v) }
-func HxReplaceUrl(v string) html.Attribute { return Attr("hx-replace-url", v) }
-func HxSelect(v string) html.Attribute     { return Attr("hx-select", v) }
-func HxSelectOob(v string) html.Attribute  { return Attr("hx-select-oob", v) }
-func HxSwap(v string) html.Attribute       { return Attr("hx-swap", v) }
-func HxSwapOob(v string) html.Attribute    { return Attr("hx-swap-oob", v) }
-func HxTarget(v string) html.Attribute     { return Attr("hx-target", v) }
-func HxTrigger(v string) html.Attribute    { return Attr("hx-trigger", v) }
-func HxVals(v string) html.Attribute       { return Attr("hx-vals", v) }
-func HxOn(event, v string) html.Attribute  { return Attr("hx-on:"+event, v) }
+// Datetime sets the datetime attribute of Time, Ins and Del in RFC 3339 form.
+// DatetimeDate writes only the date, e.g. "2025-03-01", and DatetimeLayout
+// formats t with layout, for the other forms HTML allows, such as a month
+// ("2006-01") or a time ("15:04").
+func Datetime(t time.Time) html.Attribute     { return Attr("datetime", t.Format(time.RFC3339)) }
+func DatetimeDate(t time.Time) html.Attribute { return Attr("datetime", t.Format(time.DateOnly)) }
+func DatetimeLayout(t time.Time, layout string) html.Attribute {
+	return Attr("datetime", t.Format(layout))
+}
 
-// alpine.js attributes
+// URL attributes. The URL variants take a *url.URL and the Query variants
+// append q, encoded, to path.
 
-func X(directive, v string) html.Attribute { return Attr("x-"+directive, v) }
-func XOn(event, v string) html.Attribute   { return Attr("@"+event, v) }
-func XBind(attr, v string) html.Attribute  { return Attr(":"+attr, v) }
+func ActionURL(u *url.URL) html.Attribute                  { return Action(u.String()) }
+func ActionQuery(path string, q url.Values) html.Attribute { return Action(withQuery(path, q)) }
+func HrefURL(u *url.URL) html.Attribute                    { return Href(u.String()) }
+func HrefQuery(path string, q url.Values) html.Attribute   { return Href(withQuery(path, q)) }
+func SrcURL(u *url.URL) html.Attribute                     { return Src(u.String()) }
+func SrcQuery(path string, q url.Values) html.Attribute    { return Src(withQuery(path, q)) }
+
+// formatFloat formats v the shortest way that round-trips, which is always a
+// valid HTML floating-point number for finite v.
+func formatFloat(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+
+// withQuery appends the encoded query q to path, which may have a query or
+// fragment already.
+func withQuery(path string, q url.Values) string {
+	if len(q) == 0 {
+		return path
+	}
+	path, frag, hasFrag := strings.Cut(path, "#")
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+	path += sep + q.Encode()
+	if hasFrag {
+		path += "#" + frag
+	}
+	return path
+}
@@ -0,0 +1,464 @@
+// Code generated by "go run ./internal/gen"; DO NOT EDIT.
+
+package ht
+
+import "golang.org/x/net/html"
+
+// AbbrAttr sets the abbr attribute of <th>: an alternative label for the header cell.
+func AbbrAttr(v string) html.Attribute { return Attr("abbr", v) }
+
+// Accept sets the accept attribute of <input>: the file types expected in a file upload.
+func Accept(v string) html.Attribute { return Attr("accept", v) }
+
+// AcceptCharset sets the accept-charset attribute of <form>: the character encoding to use for form submission.
+func AcceptCharset(v string) html.Attribute { return Attr("accept-charset", v) }
+
+// Accesskey sets the global accesskey attribute: a keyboard shortcut to activate or focus the element.
+func Accesskey(v string) html.Attribute { return Attr("accesskey", v) }
+
+// Action sets the action attribute of <form>: the URL to use for form submission.
+func Action(v string) html.Attribute { return Attr("action", v) }
+
+// Allow sets the allow attribute of <iframe>: the permissions policy for the frame's contents.
+func Allow(v string) html.Attribute { return Attr("allow", v) }
+
+// Allowfullscreen sets the boolean allowfullscreen attribute of <iframe>: allows the frame's contents to go fullscreen.
+func Allowfullscreen() html.Attribute { return Attr("allowfullscreen", "") }
+
+// Alpha sets the boolean alpha attribute of <input>: allows a color's alpha component to be set.
+func Alpha() html.Attribute { return Attr("alpha", "") }
+
+// Alt sets the alt attribute of <area>, <img> and <input>: the replacement text for when images are not available.
+func Alt(v string) html.Attribute { return Attr("alt", v) }
+
+// As sets the as attribute of <link>: the destination of a preload request.
+func As(v string) html.Attribute { return Attr("as", v) }
+
+// Async sets the boolean async attribute of <script>: runs the script when it is available, without blocking the page.
+func Async() html.Attribute { return Attr("async", "") }
+
+// Autocapitalize sets the global autocapitalize attribute: the recommended autocapitalization behavior.
+func Autocapitalize(v string) html.Attribute { return Attr("autocapitalize", v) }
+
+// Autocomplete sets the autocomplete attribute of <form>, <input>, <select> and <textarea>: a hint for the form autofill feature.
+func Autocomplete(v string) html.Attribute { return Attr("autocomplete", v) }
+
+// Autocorrect sets the global autocorrect attribute: whether automatic spelling correction is enabled.
+func Autocorrect(v string) html.Attribute { return Attr("autocorrect", v) }
+
+// Autofocus sets the global boolean autofocus attribute: focuses the element when the page is loaded.
+func Autofocus() html.Attribute { return Attr("autofocus", "") }
+
+// Autoplay sets the boolean autoplay attribute of <audio> and <video>: starts playing the media automatically.
+func Autoplay() html.Attribute { return Attr("autoplay", "") }
+
+// Blocking sets the blocking attribute of <link>, <script> and <style>: whether the element is potentially render-blocking.
+func Blocking(v string) html.Attribute { return Attr("blocking", v) }
+
+// Charset sets the charset attribute of <meta>: the character encoding declaration.
+func Charset(v string) html.Attribute { return Attr("charset", v) }
+
+// Checked sets the boolean checked attribute of <input>: whether the control is checked.
+func Checked() html.Attribute { return Attr("checked", "") }
+
+// CiteAttr sets the cite attribute of <blockquote>, <del>, <ins> and <q>: a link to the source of the quotation or more information about the edit.
+func CiteAttr(v string) html.Attribute { return Attr("cite", v) }
+
+// Closedby sets the closedby attribute of <dialog>: which user actions close the dialog.
+func Closedby(v string) html.Attribute { return Attr("closedby", v) }
+
+// Color sets the color attribute of <link>: the color to use when customizing a site's icon.
+func Color(v string) html.Attribute { return Attr("color", v) }
+
+// Colorspace sets the colorspace attribute of <input>: the color space of the serialized color.
+func Colorspace(v string) html.Attribute { return Attr("colorspace", v) }
+
+// Cols sets the cols attribute of <textarea>: the maximum number of characters per line.
+func Cols(v string) html.Attribute { return Attr("cols", v) }
+
+// Colspan sets the colspan attribute of <td> and <th>: the number of columns the cell spans.
+func Colspan(v string) html.Attribute { return Attr("colspan", v) }
+
+// Command sets the command attribute of <button>: the command to invoke on the element named by commandfor.
+func Command(v string) html.Attribute { return Attr("command", v) }
+
+// Commandfor sets the commandfor attribute of <button>: the id of the element the button controls.
+func Commandfor(v string) html.Attribute { return Attr("commandfor", v) }
+
+// Contenteditable sets the global contenteditable attribute: whether the element is editable.
+func Contenteditable(v string) html.Attribute { return Attr("contenteditable", v) }
+
+// Controls sets the boolean controls attribute of <audio> and <video>: shows the browser's media controls.
+func Controls() html.Attribute { return Attr("controls", "") }
+
+// Coords sets the coords attribute of <area>: the coordinates of the shape in an image map.
+func Coords(v string) html.Attribute { return Attr("coords", v) }
+
+// Crossorigin sets the crossorigin attribute of <audio>, <img>, <link>, <script> and <video>: how the element handles cross-origin requests.
+func Crossorigin(v string) html.Attribute { return Attr("crossorigin", v) }
+
+// DataAttr sets the data attribute of <object>: the address of the resource.
+func DataAttr(v string) html.Attribute { return Attr("data", v) }
+
+// Decoding sets the decoding attribute of <img>: a decoding hint for presenting the image.
+func Decoding(v string) html.Attribute { return Attr("decoding", v) }
+
+// Default sets the boolean default attribute of <track>: enables the track if no other text track is more suitable.
+func Default() html.Attribute { return Attr("default", "") }
+
+// Defer sets the boolean defer attribute of <script>: defers running the script until the document is parsed.
+func Defer() html.Attribute { return Attr("defer", "") }
+
+// Dir sets the global dir attribute: the text directionality of the element.
+func Dir(v string) html.Attribute { return Attr("dir", v) }
+
+// Dirname sets the dirname attribute of <input> and <textarea>: the name of the form field that submits the element's directionality.
+func Dirname(v string) html.Attribute { return Attr("dirname", v) }
+
+// Disabled sets the boolean disabled attribute of <button>, <input>, <optgroup>, <option>, <select>, <textarea>, <fieldset> and <link>: whether the control is disabled.
+func Disabled() html.Attribute { return Attr("disabled", "") }
+
+// Download sets the download attribute of <a> and <area>: downloads the resource instead of navigating to it, with an optional filename.
+func Download(v string) html.Attribute { return Attr("download", v) }
+
+// Draggable sets the global draggable attribute: whether the element is draggable.
+func Draggable(v string) html.Attribute { return Attr("draggable", v) }
+
+// Enctype sets the enctype attribute of <form>: the encoding type to use for form submission.
+func Enctype(v string) html.Attribute { return Attr("enctype", v) }
+
+// Enterkeyhint sets the global enterkeyhint attribute: a hint for the action label of the virtual keyboard's enter key.
+func Enterkeyhint(v string) html.Attribute { return Attr("enterkeyhint", v) }
+
+// Exportparts sets the global exportparts attribute: the shadow parts to expose outside the shadow tree.
+func Exportparts(v string) html.Attribute { return Attr("exportparts", v) }
+
+// Fetchpriority sets the fetchpriority attribute of <img>, <link> and <script>: the priority of fetches the element starts.
+func Fetchpriority(v string) html.Attribute { return Attr("fetchpriority", v) }
+
+// For sets the for attribute of <label> and <output>: the id of the control the label is for, or of the controls an output is calculated from.
+func For(v string) html.Attribute { return Attr("for", v) }
+
+// FormAttr sets the form attribute of <button>, <fieldset>, <input>, <object>, <output>, <select> and <textarea>: the id of the form the element belongs to.
+func FormAttr(v string) html.Attribute { return Attr("form", v) }
+
+// Formaction sets the formaction attribute of <button> and <input>: the URL to use for form submission, overriding the form's action.
+func Formaction(v string) html.Attribute { return Attr("formaction", v) }
+
+// Formenctype sets the formenctype attribute of <button> and <input>: the encoding type to use for form submission, overriding the form's enctype.
+func Formenctype(v string) html.Attribute { return Attr("formenctype", v) }
+
+// Formmethod sets the formmethod attribute of <button> and <input>: the HTTP method to use for form submission, overriding the form's method.
+func Formmethod(v string) html.Attribute { return Attr("formmethod", v) }
+
+// Formnovalidate sets the boolean formnovalidate attribute of <button> and <input>: skips form validation on submission.
+func Formnovalidate() html.Attribute { return Attr("formnovalidate", "") }
+
+// Formtarget sets the formtarget attribute of <button> and <input>: the navigable for form submission, overriding the form's target.
+func Formtarget(v string) html.Attribute { return Attr("formtarget", v) }
+
+// Headers sets the headers attribute of <td> and <th>: the ids of the header cells for the cell.
+func Headers(v string) html.Attribute { return Attr("headers", v) }
+
+// Height sets the height attribute of <canvas>, <embed>, <iframe>, <img>, <input>, <object>, <source> and <video>: the vertical dimension.
+func Height(v string) html.Attribute { return Attr("height", v) }
+
+// Hidden sets the global boolean hidden attribute: hides the element.
+func Hidden() html.Attribute { return Attr("hidden", "") }
+
+// High sets the high attribute of <meter>: the low limit of the high range.
+func High(v string) html.Attribute { return Attr("high", v) }
+
+// Href sets the href attribute of <a>, <area>, <base> and <link>: the address of the hyperlink.
+func Href(v string) html.Attribute { return Attr("href", v) }
+
+// Hreflang sets the hreflang attribute of <a> and <link>: the language of the linked resource.
+func Hreflang(v string) html.Attribute { return Attr("hreflang", v) }
+
+// HttpEquiv sets the http-equiv attribute of <meta>: a pragma directive.
+func HttpEquiv(v string) html.Attribute { return Attr("http-equiv", v) }
+
+// Id sets the global id attribute: the element's unique identifier.
+func Id(v string) html.Attribute { return Attr("id", v) }
+
+// Imagesizes sets the imagesizes attribute of <link>: the image sizes for different page layouts, for preloading.
+func Imagesizes(v string) html.Attribute { return Attr("imagesizes", v) }
+
+// Imagesrcset sets the imagesrcset attribute of <link>: the images to use in different situations, for preloading.
+func Imagesrcset(v string) html.Attribute { return Attr("imagesrcset", v) }
+
+// Inert sets the global boolean inert attribute: makes the element and its descendants non-interactive.
+func Inert() html.Attribute { return Attr("inert", "") }
+
+// Inputmode sets the global inputmode attribute: a hint for the kind of virtual keyboard to show.
+func Inputmode(v string) html.Attribute { return Attr("inputmode", v) }
+
+// Integrity sets the integrity attribute of <link> and <script>: the subresource integrity metadata.
+func Integrity(v string) html.Attribute { return Attr("integrity", v) }
+
+// Is sets the global is attribute: the name of the customized built-in element to create.
+func Is(v string) html.Attribute { return Attr("is", v) }
+
+// Ismap sets the boolean ismap attribute of <img>: marks the image as a server-side image map.
+func Ismap() html.Attribute { return Attr("ismap", "") }
+
+// Itemid sets the global itemid attribute: the global identifier of a microdata item.
+func Itemid(v string) html.Attribute { return Attr("itemid", v) }
+
+// Itemprop sets the global itemprop attribute: the property names of a microdata item.
+func Itemprop(v string) html.Attribute { return Attr("itemprop", v) }
+
+// Itemref sets the global itemref attribute: the ids of elements with additional properties of a microdata item.
+func Itemref(v string) html.Attribute { return Attr("itemref", v) }
+
+// Itemscope sets the global boolean itemscope attribute: introduces a microdata item.
+func Itemscope() html.Attribute { return Attr("itemscope", "") }
+
+// Itemtype sets the global itemtype attribute: the item types of a microdata item.
+func Itemtype(v string) html.Attribute { return Attr("itemtype", v) }
+
+// Kind sets the kind attribute of <track>: the type of text track.
+func Kind(v string) html.Attribute { return Attr("kind", v) }
+
+// LabelAttr sets the label attribute of <optgroup>, <option> and <track>: the user-visible label.
+func LabelAttr(v string) html.Attribute { return Attr("label", v) }
+
+// Lang sets the global lang attribute: the language of the element.
+func Lang(v string) html.Attribute { return Attr("lang", v) }
+
+// List sets the list attribute of <input>: the id of a datalist with autocomplete options.
+func List(v string) html.Attribute { return Attr("list", v) }
+
+// Loading sets the loading attribute of <iframe> and <img>: when to load the resource.
+func Loading(v string) html.Attribute { return Attr("loading", v) }
+
+// Loop sets the boolean loop attribute of <audio> and <video>: loops the media.
+func Loop() html.Attribute { return Attr("loop", "") }
+
+// Low sets the low attribute of <meter>: the high limit of the low range.
+func Low(v string) html.Attribute { return Attr("low", v) }
+
+// Max sets the max attribute of <input>, <meter> and <progress>: the maximum value.
+func Max(v string) html.Attribute { return Attr("max", v) }
+
+// Maxlength sets the maxlength attribute of <input> and <textarea>: the maximum length of the value.
+func Maxlength(v string) html.Attribute { return Attr("maxlength", v) }
+
+// Media sets the media attribute of <link>, <meta>, <source> and <style>: the media the element applies to.
+func Media(v string) html.Attribute { return Attr("media", v) }
+
+// Method sets the method attribute of <form>: the HTTP method to use for form submission.
+func Method(v string) html.Attribute { return Attr("method", v) }
+
+// Min sets the min attribute of <input> and <meter>: the minimum value.
+func Min(v string) html.Attribute { return Attr("min", v) }
+
+// Minlength sets the minlength attribute of <input> and <textarea>: the minimum length of the value.
+func Minlength(v string) html.Attribute { return Attr("minlength", v) }
+
+// Multiple sets the boolean multiple attribute of <input> and <select>: allows multiple values.
+func Multiple() html.Attribute { return Attr("multiple", "") }
+
+// Muted sets the boolean muted attribute of <audio> and <video>: mutes the media by default.
+func Muted() html.Attribute { return Attr("muted", "") }
+
+// Name sets the name attribute of <button>, <details>, <fieldset>, <form>, <iframe>, <input>, <map>, <meta>, <object>, <output>, <select>, <slot> and <textarea>: the name of the element, e.g. of the form control.
+func Name(v string) html.Attribute { return Attr("name", v) }
+
+// Nomodule sets the boolean nomodule attribute of <script>: skips the script in browsers that support modules.
+func Nomodule() html.Attribute { return Attr("nomodule", "") }
+
+// Nonce sets the global nonce attribute: the cryptographic nonce for Content Security Policy checks.
+func Nonce(v string) html.Attribute { return Attr("nonce", v) }
+
+// Novalidate sets the boolean novalidate attribute of <form>: skips form validation on submission.
+func Novalidate() html.Attribute { return Attr("novalidate", "") }
+
+// Open sets the boolean open attribute of <details> and <dialog>: whether the details or dialog are showing.
+func Open() html.Attribute { return Attr("open", "") }
+
+// Optimum sets the optimum attribute of <meter>: the optimum value.
+func Optimum(v string) html.Attribute { return Attr("optimum", v) }
+
+// Part sets the global part attribute: the shadow part names of the element.
+func Part(v string) html.Attribute { return Attr("part", v) }
+
+// Pattern sets the pattern attribute of <input>: the pattern the value must match.
+func Pattern(v string) html.Attribute { return Attr("pattern", v) }
+
+// Ping sets the ping attribute of <a> and <area>: the URLs to ping when the hyperlink is followed.
+func Ping(v string) html.Attribute { return Attr("ping", v) }
+
+// Placeholder sets the placeholder attribute of <input> and <textarea>: a hint shown while the control is empty.
+func Placeholder(v string) html.Attribute { return Attr("placeholder", v) }
+
+// Playsinline sets the boolean playsinline attribute of <video>: plays the video inline instead of fullscreen.
+func Playsinline() html.Attribute { return Attr("playsinline", "") }
+
+// Popover sets the global popover attribute: makes the element a popover.
+func Popover(v string) html.Attribute { return Attr("popover", v) }
+
+// Popovertarget sets the popovertarget attribute of <button> and <input>: the id of the popover the button controls.
+func Popovertarget(v string) html.Attribute { return Attr("popovertarget", v) }
+
+// Popovertargetaction sets the popovertargetaction attribute of <button> and <input>: whether the button shows, hides or toggles its popover.
+func Popovertargetaction(v string) html.Attribute { return Attr("popovertargetaction", v) }
+
+// Poster sets the poster attribute of <video>: the image to show before the video plays.
+func Poster(v string) html.Attribute { return Attr("poster", v) }
+
+// Preload sets the preload attribute of <audio> and <video>: how much of the media to buffer in advance.
+func Preload(v string) html.Attribute { return Attr("preload", v) }
+
+// Readonly sets the boolean readonly attribute of <input> and <textarea>: prevents the value from being edited.
+func Readonly() html.Attribute { return Attr("readonly", "") }
+
+// Referrerpolicy sets the referrerpolicy attribute of <a>, <area>, <iframe>, <img>, <link> and <script>: the referrer policy for fetches the element starts.
+func Referrerpolicy(v string) html.Attribute { return Attr("referrerpolicy", v) }
+
+// Rel sets the rel attribute of <a>, <area>, <form> and <link>: the relationship between the document and the linked resource.
+func Rel(v string) html.Attribute { return Attr("rel", v) }
+
+// Required sets the boolean required attribute of <input>, <select> and <textarea>: whether the control is required for form submission.
+func Required() html.Attribute { return Attr("required", "") }
+
+// Reversed sets the boolean reversed attribute of <ol>: numbers the list backwards.
+func Reversed() html.Attribute { return Attr("reversed", "") }
+
+// Role sets the global role attribute: the ARIA role of the element, from WAI-ARIA.
+func Role(v string) html.Attribute { return Attr("role", v) }
+
+// Rows sets the rows attribute of <textarea>: the number of lines to show.
+func Rows(v string) html.Attribute { return Attr("rows", v) }
+
+// Rowspan sets the rowspan attribute of <td> and <th>: the number of rows the cell spans.
+func Rowspan(v string) html.Attribute { return Attr("rowspan", v) }
+
+// Sandbox sets the sandbox attribute of <iframe>: the security rules for the frame's contents.
+func Sandbox(v string) html.Attribute { return Attr("sandbox", v) }
+
+// Scope sets the scope attribute of <th>: the cells the header cell applies to.
+func Scope(v string) html.Attribute { return Attr("scope", v) }
+
+// Selected sets the boolean selected attribute of <option>: whether the option is selected by default.
+func Selected() html.Attribute { return Attr("selected", "") }
+
+// Shadowrootclonable sets the boolean shadowrootclonable attribute of <template>: makes the declarative shadow root clonable.
+func Shadowrootclonable() html.Attribute { return Attr("shadowrootclonable", "") }
+
+// Shadowrootcustomelementregistry sets the boolean shadowrootcustomelementregistry attribute of <template>: gives the declarative shadow root its own custom element registry.
+func Shadowrootcustomelementregistry() html.Attribute {
+	return Attr("shadowrootcustomelementregistry", "")
+}
+
+// Shadowrootdelegatesfocus sets the boolean shadowrootdelegatesfocus attribute of <template>: makes the declarative shadow root delegate focus.
+func Shadowrootdelegatesfocus() html.Attribute { return Attr("shadowrootdelegatesfocus", "") }
+
+// Shadowrootmode sets the shadowrootmode attribute of <template>: creates a declarative shadow root in the given mode.
+func Shadowrootmode(v string) html.Attribute { return Attr("shadowrootmode", v) }
+
+// Shadowrootserializable sets the boolean shadowrootserializable attribute of <template>: makes the declarative shadow root serializable.
+func Shadowrootserializable() html.Attribute { return Attr("shadowrootserializable", "") }
+
+// Shape sets the shape attribute of <area>: the kind of shape in an image map.
+func Shape(v string) html.Attribute { return Attr("shape", v) }
+
+// Size sets the size attribute of <input> and <select>: the size of the control.
+func Size(v string) html.Attribute { return Attr("size", v) }
+
+// Sizes sets the sizes attribute of <img>, <link> and <source>: the image sizes for different page layouts.
+func Sizes(v string) html.Attribute { return Attr("sizes", v) }
+
+// SlotAttr sets the global slot attribute: the name of the shadow tree slot to place the element in.
+func SlotAttr(v string) html.Attribute { return Attr("slot", v) }
+
+// SpanAttr sets the span attribute of <col> and <colgroup>: the number of columns spanned.
+func SpanAttr(v string) html.Attribute { return Attr("span", v) }
+
+// Spellcheck sets the global spellcheck attribute: whether the element is spellchecked.
+func Spellcheck(v string) html.Attribute { return Attr("spellcheck", v) }
+
+// Src sets the src attribute of <audio>, <embed>, <iframe>, <img>, <input>, <script>, <source>, <track> and <video>: the address of the resource.
+func Src(v string) html.Attribute { return Attr("src", v) }
+
+// Srcdoc sets the srcdoc attribute of <iframe>: a document to render in the frame.
+func Srcdoc(v string) html.Attribute { return Attr("srcdoc", v) }
+
+// Srclang sets the srclang attribute of <track>: the language of the text track.
+func Srclang(v string) html.Attribute { return Attr("srclang", v) }
+
+// Srcset sets the srcset attribute of <img> and <source>: the images to use in different situations.
+func Srcset(v string) html.Attribute { return Attr("srcset", v) }
+
+// Start sets the start attribute of <ol>: the starting value of the list.
+func Start(v string) html.Attribute { return Attr("start", v) }
+
+// Step sets the step attribute of <input>: the granularity of the value.
+func Step(v string) html.Attribute { return Attr("step", v) }
+
+// StyleAttr sets the global style attribute: the element's inline CSS declarations.
+func StyleAttr(v string) html.Attribute { return Attr("style", v) }
+
+// Tabindex sets the global tabindex attribute: whether the element is focusable and where it comes in the tab order.
+func Tabindex(v string) html.Attribute { return Attr("tabindex", v) }
+
+// Target sets the target attribute of <a>, <area>, <base> and <form>: the navigable for hyperlink navigation or form submission.
+func Target(v string) html.Attribute { return Attr("target", v) }
+
+// TitleAttr sets the global title attribute: advisory information for the element, shown as a tooltip.
+func TitleAttr(v string) html.Attribute { return Attr("title", v) }
+
+// Translate sets the global translate attribute: whether the element is translated when the page is localized.
+func Translate(v string) html.Attribute { return Attr("translate", v) }
+
+// Type sets the type attribute of <a>, <button>, <embed>, <input>, <link>, <object>, <ol>, <script>, <source> and <style>: the type of the element, control or linked resource.
+func Type(v string) html.Attribute { return Attr("type", v) }
+
+// Usemap sets the usemap attribute of <img>: the name of the image map to use.
+func Usemap(v string) html.Attribute { return Attr("usemap", v) }
+
+// Value sets the value attribute of <button>, <data>, <input>, <li>, <meter>, <option> and <progress>: the value of the element.
+func Value(v string) html.Attribute { return Attr("value", v) }
+
+// Width sets the width attribute of <canvas>, <embed>, <iframe>, <img>, <input>, <object>, <source> and <video>: the horizontal dimension.
+func Width(v string) html.Attribute { return Attr("width", v) }
+
+// Wrap sets the wrap attribute of <textarea>: how the value is wrapped for form submission.
+func Wrap(v string) html.Attribute { return Attr("wrap", v) }
+
+// Writingsuggestions sets the global writingsuggestions attribute: whether the browser offers writing suggestions.
+func Writingsuggestions(v string) html.Attribute { return Attr("writingsuggestions", v) }
+
+// booleanAttrs are the HTML attributes whose presence alone means true.
+var booleanAttrs = map[string]bool{
+	"allowfullscreen":                 true,
+	"alpha":                           true,
+	"async":                           true,
+	"autofocus":                       true,
+	"autoplay":                        true,
+	"checked":                         true,
+	"controls":                        true,
+	"default":                         true,
+	"defer":                           true,
+	"disabled":                        true,
+	"formnovalidate":                  true,
+	"hidden":                          true,
+	"inert":                           true,
+	"ismap":                           true,
+	"itemscope":                       true,
+	"loop":                            true,
+	"multiple":                        true,
+	"muted":                           true,
+	"nomodule":                        true,
+	"novalidate":                      true,
+	"open":                            true,
+	"playsinline":                     true,
+	"readonly":                        true,
+	"required":                        true,
+	"reversed":                        true,
+	"selected":                        true,
+	"shadowrootclonable":              true,
+	"shadowrootcustomelementregistry": true,
+	"shadowrootdelegatesfocus":        true,
+	"shadowrootserializable":          true,
+}
@@ -0,0 +1,43 @@
+package ht
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTypedAttrs(t *testing.T) {
+	u, _ := url.Parse("https://example.com/a b?q=1")
+	when := time.Date(2025, 3, 1, 9, 30, 0, 0, time.FixedZone("", 3600))
+	for _, tt := range []struct {
+		got  any
+		want string
+	}{
+		{ColspanInt(2), `colspan="2"`},
+		{MinFloat(-0.5), `min="-0.5"`},
+		{StepFloat(1e-3), `step="0.001"`},
+		{ValueInt(42), `value="42"`},
+		{Datetime(when), `datetime="2025-03-01T09:30:00+01:00"`},
+		{DatetimeDate(when), `datetime="2025-03-01"`},
+		{DatetimeLayout(when, "2006-01"), `datetime="2025-03"`},
+		{HrefURL(u), `href="https://example.com/a%20b?q=1"`},
+		{HxGetQuery("/search", url.Values{"q": {"a&b"}, "page": {"2"}}), `hx-get="/search?page=2&amp;q=a%26b"`},
+		{HrefQuery("/list?sort=asc#top", url.Values{"n": {"1"}}), `href="/list?sort=asc&amp;n=1#top"`},
+		{Bool("checked", true), `checked=""`},
+		{Bool("checked", false), ``},
+	} {
+		var b strings.Builder
+		if err := Render(context.Background(), &b, Input(tt.got)); err != nil {
+			t.Fatal(err)
+		}
+		want := "<input/>"
+		if tt.want != "" {
+			want = "<input " + tt.want + "/>"
+		}
+		if stripSources(b.String()) != want {
+			t.Errorf("got %s, want %s", stripSources(b.String()), want)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package ht
 
 import (
 	"bytes"
+	"context"
 	"testing"
 
 	"golang.org/x/net/html"
@@ -60,3 +61,79 @@ func BenchmarkComplexNodeRender(b *testing.B) {
 		_ = html.Render(&buf, node)
 	}
 }
+
+// benchPage builds a list-and-table page typical of the examples, with the
+// kind of indentation whitespace that hand-written components tend to carry.
+func benchPage() *html.Node {
+	var rows, items []*html.Node
+	for range 50 {
+		rows = append(rows, Tr(
+			Td(Text("Row title"), Text("\n\t\t")),
+			Td(Colspan("1"), Class("text-center"), Text("Director")),
+			Td(Input(Type("checkbox"), Checked(), Disabled())),
+		))
+		items = append(items, Text("\n\t"), Li(Class("menu-item"), A(Href("/item"), Text("Item"))))
+	}
+	return Document(
+		Doctype("html"),
+		Html(
+			Lang("en"),
+			Head(Meta(Charset("utf-8")), Title(Text("Benchmark"))),
+			Body(
+				Ul(Class("menu"), Fragment(items)),
+				Table(Class("table"), Tbody(Fragment(rows))),
+			),
+		),
+	)
+}
+
+func BenchmarkPageRender(b *testing.B) {
+	page := benchPage()
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		_ = Render(context.Background(), &buf, page)
+	}
+	b.ReportMetric(float64(buf.Len()), "bytes/page")
+}
+
+func BenchmarkPageRenderMinify(b *testing.B) {
+	page := benchPage()
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		_ = Render(context.Background(), &buf, page, WithMinify())
+	}
+	b.ReportMetric(float64(buf.Len()), "bytes/page")
+}
+
+func benchNav() *html.Node {
+	var items []*html.Node
+	for range 35 {
+		items = append(items, Li(Input(Type("radio"), Name("theme"), Class("btn btn-sm btn-ghost"), Value("light"))))
+	}
+	return Div(
+		Class("navbar bg-base-100 shadow-sm"),
+		Div(Class("flex-1"), A(Class("btn btn-ghost text-xl"), Href("/"), Text("Home"))),
+		Ul(Class("menu dropdown-content"), Fragment(items)),
+	)
+}
+
+func BenchmarkNavRender(b *testing.B) {
+	b.ReportAllocs()
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		_ = Render(context.Background(), &buf, Div(benchNav()))
+	}
+}
+
+func BenchmarkStaticNavRender(b *testing.B) {
+	b.ReportAllocs()
+	nav := Static(benchNav)
+	var buf bytes.Buffer
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		_ = Render(context.Background(), &buf, Div(nav))
+	}
+}
@@ -0,0 +1,195 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	h "golang.org/x/net/html"
+)
+
+// Datastar attributes, as documented at https://data-star.dev/reference/attributes.
+// Helpers that take expressions format them with JS, so Go values can be
+// passed in as args. Modifiers go after the name, e.g.
+// DsOn("input__debounce.500ms", "@get('/search')").
+
+// DsSignals merges v, usually a struct or map, into the page's signals,
+// encoded as a JavaScript object.
+func DsSignals(v any) h.Attribute { return Attr("data-signals", JS("%v", v)) }
+
+// DsSignal sets the named signal to the value of the expression.
+func DsSignal(name, v string, args ...any) h.Attribute {
+	return Attr("data-signals-"+name, JS(v, args...))
+}
+
+// DsComputed creates a read-only signal computed from the expression.
+func DsComputed(name, v string, args ...any) h.Attribute {
+	return Attr("data-computed-"+name, JS(v, args...))
+}
+
+// DsOn runs the expression when the event fires.
+func DsOn(event, v string, args ...any) h.Attribute { return Attr("data-on-"+event, JS(v, args...)) }
+
+// DsBind binds the value of an input, select or textarea to the named signal.
+func DsBind(signal string) h.Attribute { return Attr("data-bind-"+signal, "") }
+
+// DsText sets the element's text to the value of the expression.
+func DsText(v string, args ...any) h.Attribute { return Attr("data-text", JS(v, args...)) }
+
+// DsShow shows or hides the element as the expression changes.
+func DsShow(v string, args ...any) h.Attribute { return Attr("data-show", JS(v, args...)) }
+
+// DsClass adds the class while the expression is true.
+func DsClass(class, v string, args ...any) h.Attribute {
+	return Attr("data-class-"+class, JS(v, args...))
+}
+
+// DsAttr sets the attribute to the value of the expression.
+func DsAttr(attr, v string, args ...any) h.Attribute { return Attr("data-attr-"+attr, JS(v, args...)) }
+
+// DsIndicator sets the named signal to true while a request from the element
+// is in flight.
+func DsIndicator(signal string) h.Attribute { return Attr("data-indicator-"+signal, "") }
+
+// DsEffect runs the expression whenever the signals it uses change.
+func DsEffect(v string, args ...any) h.Attribute { return Attr("data-effect", JS(v, args...)) }
+
+// DsRef creates a signal named name that refers to the element.
+func DsRef(name string) h.Attribute { return Attr("data-ref-"+name, "") }
+
+// Datastar server-sent events, as documented at
+// https://data-star.dev/reference/sse_events. Serve them from a handler with
+// the Content-Type text/event-stream.
+
+// PatchMode is how PatchElements applies elements to the page.
+type PatchMode string
+
+const (
+	PatchOuter   PatchMode = "outer"   // morph the element with the same id, the default
+	PatchInner   PatchMode = "inner"   // morph the children of the selected element
+	PatchReplace PatchMode = "replace" // replace the element with the same id
+	PatchPrepend PatchMode = "prepend" // insert before the first child of the selected element
+	PatchAppend  PatchMode = "append"  // insert after the last child of the selected element
+	PatchBefore  PatchMode = "before"  // insert before the selected element
+	PatchAfter   PatchMode = "after"   // insert after the selected element
+	PatchRemove  PatchMode = "remove"  // remove the selected element
+)
+
+// PatchOption configures a Datastar server-sent event.
+type PatchOption func(*patchEvent)
+
+// patchEvent is the optional part of a Datastar event.
+type patchEvent struct {
+	id             string
+	retry          time.Duration
+	selector       string
+	mode           PatchMode
+	viewTransition bool
+	onlyIfMissing  bool
+}
+
+// WithEventID sets the event id, which the browser sends back as
+// Last-Event-ID when it reconnects. Writing the event fails if id contains a
+// line break.
+func WithEventID(id string) PatchOption { return func(e *patchEvent) { e.id = id } }
+
+// WithRetry sets how long the browser waits before reconnecting.
+func WithRetry(d time.Duration) PatchOption { return func(e *patchEvent) { e.retry = d } }
+
+// WithSelector selects the element PatchElements applies to, instead of the
+// elements with the ids of the patched ones. Writing the event fails if
+// selector contains a line break.
+func WithSelector(selector string) PatchOption {
+	return func(e *patchEvent) { e.selector = selector }
+}
+
+// WithMode sets how PatchElements applies the elements.
+func WithMode(mode PatchMode) PatchOption { return func(e *patchEvent) { e.mode = mode } }
+
+// WithViewTransition makes PatchElements use the View Transitions API.
+func WithViewTransition() PatchOption { return func(e *patchEvent) { e.viewTransition = true } }
+
+// WithOnlyIfMissing makes PatchSignals only set signals that do not exist yet.
+func WithOnlyIfMissing() PatchOption { return func(e *patchEvent) { e.onlyIfMissing = true } }
+
+// PatchElements writes a datastar-patch-elements event that patches the
+// rendered node into the page, and flushes it if w is an http.Flusher. A nil
+// node sends no elements, for use with WithMode(PatchRemove).
+func PatchElements(ctx context.Context, w io.Writer, node *h.Node, opts ...PatchOption) error {
+	e := newPatchEvent(opts)
+	var data []string
+	if e.selector != "" {
+		data = append(data, "selector "+e.selector)
+	}
+	if e.mode != "" && e.mode != PatchOuter {
+		data = append(data, "mode "+string(e.mode))
+	}
+	if e.viewTransition {
+		data = append(data, "useViewTransition true")
+	}
+	if node != nil {
+		var buf bytes.Buffer
+		if err := Render(ctx, &buf, node); err != nil {
+			return err
+		}
+		for line := range strings.Lines(sseNewlines.Replace(buf.String())) {
+			data = append(data, "elements "+strings.TrimSuffix(line, "\n"))
+		}
+	}
+	return e.write(w, "datastar-patch-elements", data)
+}
+
+// PatchSignals writes a datastar-patch-signals event that merges signals,
+// usually a struct or map, into the page's signals, and flushes it if w is an
+// http.Flusher. Setting a signal to nil removes it.
+func PatchSignals(w io.Writer, signals any, opts ...PatchOption) error {
+	e := newPatchEvent(opts)
+	var data []string
+	if e.onlyIfMissing {
+		data = append(data, "onlyIfMissing true")
+	}
+	data = append(data, "signals "+JS("%v", signals))
+	return e.write(w, "datastar-patch-signals", data)
+}
+
+func newPatchEvent(opts []PatchOption) *patchEvent {
+	e := &patchEvent{}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+// sseNewlines normalizes every line ending SSE recognizes to "\n".
+var sseNewlines = strings.NewReplacer("\r\n", "\n", "\r", "\n")
+
+// write writes the event with the given data lines.
+func (e *patchEvent) write(w io.Writer, event string, data []string) error {
+	if strings.ContainsAny(e.id, "\r\n") || strings.ContainsAny(e.selector, "\r\n") {
+		return errors.New("ht: Datastar event id or selector contains a line break")
+	}
+	var b strings.Builder
+	b.WriteString("event: " + event + "\n")
+	if e.id != "" {
+		b.WriteString("id: " + e.id + "\n")
+	}
+	if e.retry > 0 {
+		b.WriteString("retry: " + strconv.FormatInt(e.retry.Milliseconds(), 10) + "\n")
+	}
+	for _, d := range data {
+		b.WriteString("data: " + d + "\n")
+	}
+	b.WriteString("\n")
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return err
+	}
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDatastar(t *testing.T) {
+	for _, tt := range []struct {
+		attr any
+		want string
+	}{
+		{DsSignals(map[string]any{"count": 1}), `data-signals="{&#34;count&#34;:1}"`},
+		{DsOn("click", "$count = %v", 2), `data-on-click="$count = 2"`},
+		{DsBind("query"), `data-bind-query=""`},
+		{DsText("$count"), `data-text="$count"`},
+		{DsShow("$open"), `data-show="$open"`},
+		{DsClass("hidden", "!$open"), `data-class-hidden="!$open"`},
+		{DsAttr("disabled", "$busy"), `data-attr-disabled="$busy"`},
+		{DsIndicator("busy"), `data-indicator-busy=""`},
+	} {
+		var b strings.Builder
+		if err := Render(context.Background(), &b, Div(tt.attr)); err != nil {
+			t.Fatal(err)
+		}
+		if want := "<div " + tt.want + "></div>"; stripSources(b.String()) != want {
+			t.Errorf("got %s, want %s", stripSources(b.String()), want)
+		}
+	}
+}
+
+func TestDatastarEvents(t *testing.T) {
+	var b strings.Builder
+	err := PatchElements(context.Background(), &b, Div(Id("a"), Text("1\n2"), Raw("\r3\r\n")),
+		WithSelector("#list"), WithMode(PatchAppend), WithEventID("7"), WithRetry(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "event: datastar-patch-elements\nid: 7\nretry: 1000\ndata: selector #list\ndata: mode append\n" +
+		"data: elements <div id=\"a\">1\ndata: elements 2\ndata: elements 3\ndata: elements </div>\n\n"
+	if stripSources(b.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(b.String()), want)
+	}
+
+	b.Reset()
+	if err := PatchSignals(&b, map[string]any{"count": 2}, WithOnlyIfMissing()); err != nil {
+		t.Fatal(err)
+	}
+	want = "event: datastar-patch-signals\ndata: onlyIfMissing true\ndata: signals {\"count\":2}\n\n"
+	if stripSources(b.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(b.String()), want)
+	}
+
+	for _, opt := range []PatchOption{WithSelector("#a\ndata: elements <p>"), WithEventID("7\r")} {
+		b.Reset()
+		if err := PatchElements(context.Background(), &b, nil, opt); err == nil || b.Len() > 0 {
+			t.Errorf("got %q and error %v, want nothing written and an error", stripSources(b.String()), err)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package ht
+
+import (
+	h "golang.org/x/net/html"
+)
+
+// WithSourceComments writes the source of each element as an HTML comment in
+// front of it, instead of as a data-ht-src attribute. It only has an effect
+// in builds with the htdebug tag.
+func WithSourceComments() RenderOption {
+	return func(r *renderer) {
+		r.sourceComments = true
+	}
+}
+
+// annotate returns n with its recorded source attached for output, either as
+// a comment written in front of it or as a data-ht-src attribute on a copy.
+// Outside htdebug builds it returns n unchanged.
+func (r *renderer) annotate(n *h.Node) (*h.Node, error) {
+	if !debugSources {
+		return n, nil
+	}
+	src := sourceOf(n)
+	if src == "" {
+		return n, nil
+	}
+	if r.sourceComments {
+		return n, r.emit(Comment(" "+src+" "), 0)
+	}
+	c := *n
+	c.Attr = append(append([]h.Attribute(nil), n.Attr...), Attr("data-ht-src", src))
+	return &c, nil
+}
@@ -0,0 +1,15 @@
+//go:build !htdebug
+
+package ht
+
+import (
+	h "golang.org/x/net/html"
+)
+
+// debugSources is set in builds with the htdebug tag, where Element records
+// which Go function built each element and Render writes it out.
+const debugSources = false
+
+func recordSource(*h.Node) {}
+
+func sourceOf(*h.Node) string { return "" }
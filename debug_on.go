@@ -0,0 +1,80 @@
+//go:build htdebug
+
+package ht
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"weak"
+
+	h "golang.org/x/net/html"
+)
+
+// debugSources is set in builds with the htdebug tag, where Element records
+// which Go function built each element and Render writes it out.
+const debugSources = true
+
+// sources maps elements to the "file:line function" that built them.
+var sources sync.Map // weak.Pointer[h.Node] -> string
+
+// recordSource remembers the caller of the Element call chain that built n:
+// the first frame that is not Element or ElementNS, a generated constructor,
+// or one of the constructor packages built on them.
+func recordSource(n *h.Node) {
+	var pcs [16]uintptr
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(1, pcs[:])])
+
+	first, more := frames.Next()
+	pkg := funcPackage(first.Function)
+	for more {
+		var f runtime.Frame
+		f, more = frames.Next()
+		if constructorFrame(pkg, f) {
+			continue
+		}
+		name := f.Function[strings.LastIndex(f.Function, "/")+1:]
+		file := filepath.Join(filepath.Base(filepath.Dir(f.File)), filepath.Base(f.File))
+		key := weak.Make(n)
+		sources.Store(key, fmt.Sprintf("%s:%d %s", file, f.Line, name))
+		runtime.AddCleanup(n, func(k weak.Pointer[h.Node]) { sources.Delete(k) }, key)
+		return
+	}
+}
+
+// constructorFrame reports whether f belongs to the Element call chain of
+// pkg, this package's import path.
+func constructorFrame(pkg string, f runtime.Frame) bool {
+	switch p := funcPackage(f.Function); {
+	case p == pkg:
+		fn := strings.TrimPrefix(f.Function, pkg+".")
+		return fn == "Element" || fn == "ElementNS" || filepath.Base(f.File) == "elements_gen.go"
+	case strings.HasPrefix(p, pkg+"/"):
+		return slices.Contains(constructorPackages, p[len(pkg):]) && !strings.HasSuffix(f.File, "_test.go")
+	}
+	return false
+}
+
+// constructorPackages are the subpackages whose constructors call Element or
+// ElementNS, relative to this package.
+var constructorPackages = []string{"/svg", "/mathml"}
+
+// sourceOf returns the source recorded for n, if any.
+func sourceOf(n *h.Node) string {
+	v, _ := sources.Load(weak.Make(n))
+	s, _ := v.(string)
+	return s
+}
+
+// funcPackage returns the import path of the package a function name from
+// runtime.Frame belongs to.
+func funcPackage(fn string) string {
+	slash := strings.LastIndex(fn, "/")
+	if dot := strings.Index(fn[slash+1:], "."); dot >= 0 {
+		return fn[:slash+1+dot]
+	}
+	return fn
+}
@@ -0,0 +1,36 @@
+//go:build htdebug
+
+package ht
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSources(t *testing.T) {
+	_, file, line, _ := runtime.Caller(0)
+	page := Div(P(Text("hi")))
+	src := strings.TrimSuffix(file, "/debug_on_test.go")
+	src = src[strings.LastIndex(src, "/")+1:] + "/debug_on_test.go:" + strconv.Itoa(line+1) + " ht.TestSources"
+
+	var b strings.Builder
+	if err := Render(context.Background(), &b, page); err != nil {
+		t.Fatal(err)
+	}
+	want := `<div data-ht-src="` + src + `"><p data-ht-src="` + src + `">hi</p></div>`
+	if b.String() != want {
+		t.Errorf("got  %s\nwant %s", b.String(), want)
+	}
+
+	b.Reset()
+	if err := Render(context.Background(), &b, page, WithSourceComments()); err != nil {
+		t.Fatal(err)
+	}
+	want = `<!-- ` + src + ` --><div><!-- ` + src + ` --><p>hi</p></div>`
+	if b.String() != want {
+		t.Errorf("got  %s\nwant %s", b.String(), want)
+	}
+}
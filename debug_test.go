@@ -0,0 +1,10 @@
+package ht
+
+import "regexp"
+
+// sourceAttr matches the attribute htdebug builds add to every element.
+var sourceAttr = regexp.MustCompile(` data-ht-src="[^"]*"`)
+
+// stripSources removes the attributes htdebug builds add, so that tests
+// expect the same output with and without the tag.
+func stripSources(s string) string { return sourceAttr.ReplaceAllString(s, "") }
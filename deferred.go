@@ -0,0 +1,168 @@
+package ht
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+	"sync/atomic"
+
+	h "golang.org/x/net/html"
+	a "golang.org/x/net/html/atom"
+)
+
+// deferredSeq numbers Deferred placeholders so that their IDs stay unique
+// even when several renders write into the same response.
+var deferredSeq atomic.Uint64
+
+// deferred is the payload of a Deferred node.
+type deferred struct {
+	produce  func(ctx context.Context) *h.Node
+	fallback *h.Node
+}
+
+// deferredResult carries a resolved Deferred node back to the renderer.
+type deferredResult struct {
+	id    string
+	node  *h.Node
+	err   error
+	depth int // the depth of the placeholder, for WithMaxDepth
+}
+
+// Deferred returns a node that lets a slow component stream in after the rest
+// of the page. Render writes fallback in its place straight away and starts
+// produce on its own goroutine. Once the page itself has been written and
+// flushed, each result is appended to the same response, in the order the
+// producers finish, as a <template> followed by a small inline script that
+// swaps it into the fallback's position.
+//
+// The fallback is identified by a generated id. A nil fallback leaves an empty
+// <template> as the placeholder, and a fallback that is not an element or
+// already has an id is wrapped in a <span>. Neither the fallback nor the
+// result is modified, so both may be shared or already part of another tree.
+//
+// Producers receive a context that is cancelled if rendering fails or the
+// render context is cancelled. A nil result removes the fallback. A producer
+// that panics is reported to OnError as a *PanicError and its fallback is
+// left in place.
+func Deferred(produce func(ctx context.Context) *h.Node, fallback *h.Node) *h.Node {
+	return dynamicNode(deferredNode, "ht:deferred", &deferred{produce: produce, fallback: fallback})
+}
+
+// deferred renders the placeholder for a Deferred node and starts its
+// producer.
+func (r *renderer) deferred(n *h.Node, depth int) error {
+	d, _ := payload(n).(*deferred)
+	if d == nil {
+		return nil
+	}
+
+	id := "ht-deferred-" + strconv.FormatUint(deferredSeq.Add(1), 10)
+	placeholder := Template()
+	if d.fallback != nil {
+		// Wrap the fallback unless a shallow copy of it can carry the id, so
+		// that the shared fallback is never mutated.
+		placeholder = wrapper(a.Span, d.fallback)
+		if _, hasID := lookupAttr(d.fallback, "", "id"); d.fallback.Type == h.ElementNode && !hasID {
+			c := *d.fallback
+			c.Attr = slices.Clip(d.fallback.Attr)
+			placeholder = &c
+		}
+	}
+	placeholder.Attr = append(placeholder.Attr, Id(id))
+
+	r.startAsync()
+	r.pending++
+	res := deferredResult{id: id, depth: depth + r.depthOffset}
+	go func() {
+		res.err = recovered(func() error {
+			res.node = d.produce(r.async)
+			return nil
+		})
+		if r.async.Err() != nil {
+			// Render has finished, or a TryLazy boundary discarded the
+			// placeholder.
+			return
+		}
+		select {
+		case r.results <- res:
+		case <-r.async.Done():
+		}
+	}()
+
+	return r.node(placeholder, depth)
+}
+
+// startAsync prepares the context Deferred producers run under and the
+// channel they report back on.
+func (r *renderer) startAsync() {
+	if r.results == nil {
+		r.async, r.stopAsync = context.WithCancel(r.ctx)
+		r.results = make(chan deferredResult)
+	}
+}
+
+// resolve flushes the page and then writes every Deferred result as it
+// arrives, until none are left.
+func (r *renderer) resolve() error {
+	for r.pending > 0 {
+		if err := r.flush(); err != nil {
+			return err
+		}
+		select {
+		case res := <-r.results:
+			r.pending--
+			if res.err != nil {
+				fail(r.ctx, res.err, nil)
+				continue
+			}
+			// The template is written at the top level, but the result counts
+			// towards the depth limit at the depth of its placeholder.
+			r.depthOffset = res.depth - 1
+			err := r.node(swapFragment(res), 0)
+			r.depthOffset = 0
+			if err != nil {
+				return err
+			}
+		case <-r.done:
+			return r.ctx.Err()
+		}
+	}
+	return nil
+}
+
+// swapFragment returns the markup that moves a resolved Deferred result into
+// the place of its fallback.
+func swapFragment(res deferredResult) *h.Node {
+	tpl := res.id + "-content"
+	content := wrapper(a.Template, res.node)
+	content.Attr = []h.Attribute{Id(tpl)}
+	return Fragment(
+		content,
+		Script(Raw(`(function(t,p){if(p)p.replaceWith(t.content);t.remove()})(document.getElementById(`+
+			jsString(tpl)+`),document.getElementById(`+jsString(res.id)+`));document.currentScript.remove()`)),
+	)
+}
+
+// wrapper returns an element that only the renderer walks, so it can point at
+// child without adopting it. An attached child is replaced by a shallow copy
+// without siblings, so that only the child itself is rendered.
+func wrapper(tag a.Atom, child *h.Node) *h.Node {
+	if child != nil && (child.PrevSibling != nil || child.NextSibling != nil) {
+		if child.Type >= flushNode {
+			child = dynamicNode(child.Type, child.Data, payload(child))
+		} else {
+			c := *child
+			c.Parent, c.PrevSibling, c.NextSibling = nil, nil, nil
+			child = &c
+		}
+	}
+	return &h.Node{Type: h.ElementNode, DataAtom: tag, Data: tag.String(), FirstChild: child, LastChild: child}
+}
+
+// jsString quotes s as a JavaScript string literal that is also safe to place
+// inside a <script> element.
+func jsString(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
@@ -0,0 +1,86 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestRenderDeferred(t *testing.T) {
+	slow := make(chan struct{})
+	page := Div(
+		Deferred(func(ctx context.Context) *html.Node {
+			<-slow
+			return P(Text("slow"))
+		}, P(Class("slow"), Text("loading"))),
+		Deferred(func(ctx context.Context) *html.Node {
+			defer close(slow)
+			return P(Text("fast"))
+		}, nil),
+	)
+
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, page); err != nil {
+		t.Fatal(err)
+	}
+
+	got := stripSources(buf.String())
+	prefix := `<div><p class="slow" id="ht-deferred-`
+	if !strings.HasPrefix(got, prefix) {
+		t.Fatalf("got %q, want prefix %q", got, prefix)
+	}
+	id := got[len(prefix)-len("ht-deferred-") : strings.Index(got, `">loading`)]
+	fast, slowAt := strings.Index(got, "<p>fast</p>"), strings.Index(got, "<p>slow</p>")
+	if fast == -1 || slowAt == -1 || fast > slowAt {
+		t.Errorf("want fast result before slow result, got %q", got)
+	}
+	if !strings.Contains(got, `<template id="`+id+`-content"><p>slow</p></template><script>`) {
+		t.Errorf("slow result not swapped into its fallback: %q", got)
+	}
+}
+
+func TestRenderDeferredShared(t *testing.T) {
+	shared := Ul(Li(Text("a")), Li(Text("b")))
+	item := shared.LastChild
+	spinner := Span(Id("spinner"), Text("…"))
+	page := Div(
+		Deferred(func(ctx context.Context) *html.Node { return item }, spinner),
+		Deferred(func(ctx context.Context) *html.Node { return item }, spinner),
+	)
+
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, page); err != nil {
+		t.Fatal(err)
+	}
+	got := stripSources(buf.String())
+	if n := strings.Count(got, `<span id="spinner">…</span>`); n != 2 {
+		t.Errorf("got %d copies of the fallback, want 2: %q", n, got)
+	}
+	if n := strings.Count(got, `-content"><li>b</li></template>`); n != 2 {
+		t.Errorf("got %d results, want 2: %q", n, got)
+	}
+	if item.Parent != shared || shared.FirstChild.NextSibling != item || len(spinner.Attr) != 1 {
+		t.Error("rendering modified the shared nodes")
+	}
+}
+
+func TestRenderDeferredPanic(t *testing.T) {
+	var reported error
+	OnError = func(ctx context.Context, err error) { reported = err }
+	defer func() { OnError = nil }()
+
+	page := Div(Deferred(func(ctx context.Context) *html.Node { panic("boom") }, Text("loading")))
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, page); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(stripSources(buf.String()), "<script>") {
+		t.Errorf("failed result was swapped in: %q", stripSources(buf.String()))
+	}
+	if perr, ok := reported.(*PanicError); !ok || perr.Value != "boom" {
+		t.Errorf("reported %v, want the panic", reported)
+	}
+}
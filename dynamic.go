@@ -0,0 +1,41 @@
+package ht
+
+import (
+	"runtime"
+	"sync"
+	"weak"
+
+	h "golang.org/x/net/html"
+)
+
+// Node types that carry behaviour only Render understands. They sit in the
+// tree like any other node, but html.Render rejects them as unknown, so trees
+// containing them must be written with Render.
+const (
+	flushNode h.NodeType = 0x100 + iota
+	lazyNode
+	deferredNode
+	tryNode
+)
+
+// payloads holds the Go value attached to each dynamic node, keyed weakly so
+// that an entry disappears together with its node.
+var payloads sync.Map // weak.Pointer[h.Node] -> any
+
+// dynamicNode returns a new node of type t, with v attached to it.
+// The data string only serves to identify the node when debugging.
+func dynamicNode(t h.NodeType, data string, v any) *h.Node {
+	n := &h.Node{Type: t, Data: data}
+	if v != nil {
+		key := weak.Make(n)
+		payloads.Store(key, v)
+		runtime.AddCleanup(n, func(k weak.Pointer[h.Node]) { payloads.Delete(k) }, key)
+	}
+	return n
+}
+
+// payload returns the value attached to the dynamic node n.
+func payload(n *h.Node) any {
+	v, _ := payloads.Load(weak.Make(n))
+	return v
+}
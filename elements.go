@@ -0,0 +1,36 @@
+package ht
+
+import a "golang.org/x/net/html/atom"
+
+//go:generate go run ./internal/gen
+
+// ContentCategory is a set of the content categories an HTML element belongs
+// to, as defined by the WHATWG HTML standard. Categories that only apply in
+// some circumstances are included: an <a> is phrasing content when its
+// children are, and an <img> is interactive content when it has a usemap.
+type ContentCategory uint16
+
+const (
+	MetadataContent ContentCategory = 1 << iota
+	FlowContent
+	SectioningContent
+	HeadingContent
+	PhrasingContent
+	EmbeddedContent
+	InteractiveContent
+	FormAssociated   // listed, labelable, submittable or resettable elements
+	ScriptSupporting // elements that do not represent anything themselves
+	VoidElement      // elements that cannot have children or an end tag
+)
+
+// Categories returns the content categories of the element with the given
+// tag. It returns 0 for elements that only appear in specific contexts, such
+// as <li> or <td>, and for unknown elements.
+func Categories(tag a.Atom) ContentCategory {
+	return categories[tag]
+}
+
+// Has reports whether c includes every category in other.
+func (c ContentCategory) Has(other ContentCategory) bool {
+	return c&other == other
+}
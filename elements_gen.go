@@ -0,0 +1,438 @@
+// Code generated by "go run ./internal/gen"; DO NOT EDIT.
+
+package ht
+
+import (
+	h "golang.org/x/net/html"
+	a "golang.org/x/net/html/atom"
+)
+
+// A constructs a new <a> element: a hyperlink.
+func A(args ...any) *h.Node { return Element(a.A, args...) }
+
+// Abbr constructs a new <abbr> element: an abbreviation.
+func Abbr(args ...any) *h.Node { return Element(a.Abbr, args...) }
+
+// Address constructs a new <address> element: contact information for a page or article.
+func Address(args ...any) *h.Node { return Element(a.Address, args...) }
+
+// Area constructs a new <area> element: a hyperlink or dead area on an image map.
+func Area(args ...any) *h.Node { return Element(a.Area, args...) }
+
+// Article constructs a new <article> element: a self-contained, syndicatable or reusable composition.
+func Article(args ...any) *h.Node { return Element(a.Article, args...) }
+
+// Aside constructs a new <aside> element: a sidebar for tangentially related content.
+func Aside(args ...any) *h.Node { return Element(a.Aside, args...) }
+
+// Audio constructs a new <audio> element: an audio player.
+func Audio(args ...any) *h.Node { return Element(a.Audio, args...) }
+
+// B constructs a new <b> element: keywords.
+func B(args ...any) *h.Node { return Element(a.B, args...) }
+
+// Base constructs a new <base> element: the base URL and default target for hyperlinks and forms.
+func Base(args ...any) *h.Node { return Element(a.Base, args...) }
+
+// Bdi constructs a new <bdi> element: text directionality isolation.
+func Bdi(args ...any) *h.Node { return Element(a.Bdi, args...) }
+
+// Bdo constructs a new <bdo> element: text directionality formatting.
+func Bdo(args ...any) *h.Node { return Element(a.Bdo, args...) }
+
+// Blockquote constructs a new <blockquote> element: a section quoted from another source.
+func Blockquote(args ...any) *h.Node { return Element(a.Blockquote, args...) }
+
+// Body constructs a new <body> element: the document body.
+func Body(args ...any) *h.Node { return Element(a.Body, args...) }
+
+// Br constructs a new <br> element: a line break, e.g. in a poem or postal address.
+func Br(args ...any) *h.Node { return Element(a.Br, args...) }
+
+// Button constructs a new <button> element: a button control.
+func Button(args ...any) *h.Node { return Element(a.Button, args...) }
+
+// Canvas constructs a new <canvas> element: a scriptable bitmap canvas.
+func Canvas(args ...any) *h.Node { return Element(a.Canvas, args...) }
+
+// Caption constructs a new <caption> element: a table caption.
+func Caption(args ...any) *h.Node { return Element(a.Caption, args...) }
+
+// Cite constructs a new <cite> element: the title of a work.
+func Cite(args ...any) *h.Node { return Element(a.Cite, args...) }
+
+// Code constructs a new <code> element: computer code.
+func Code(args ...any) *h.Node { return Element(a.Code, args...) }
+
+// Col constructs a new <col> element: a table column.
+func Col(args ...any) *h.Node { return Element(a.Col, args...) }
+
+// Colgroup constructs a new <colgroup> element: a group of columns in a table.
+func Colgroup(args ...any) *h.Node { return Element(a.Colgroup, args...) }
+
+// DataElem constructs a new <data> element: a machine-readable equivalent of its content.
+func DataElem(args ...any) *h.Node { return Element(a.Data, args...) }
+
+// Datalist constructs a new <datalist> element: a container for the options of a combo box control.
+func Datalist(args ...any) *h.Node { return Element(a.Datalist, args...) }
+
+// Dd constructs a new <dd> element: the content for the corresponding dt elements.
+func Dd(args ...any) *h.Node { return Element(a.Dd, args...) }
+
+// Del constructs a new <del> element: a removal from the document.
+func Del(args ...any) *h.Node { return Element(a.Del, args...) }
+
+// Details constructs a new <details> element: a disclosure control for hiding details.
+func Details(args ...any) *h.Node { return Element(a.Details, args...) }
+
+// Dfn constructs a new <dfn> element: the defining instance of a term.
+func Dfn(args ...any) *h.Node { return Element(a.Dfn, args...) }
+
+// Dialog constructs a new <dialog> element: a dialog box or window.
+func Dialog(args ...any) *h.Node { return Element(a.Dialog, args...) }
+
+// Div constructs a new <div> element: a generic flow container.
+func Div(args ...any) *h.Node { return Element(a.Div, args...) }
+
+// Dl constructs a new <dl> element: an association list of name-value groups.
+func Dl(args ...any) *h.Node { return Element(a.Dl, args...) }
+
+// Dt constructs a new <dt> element: the legend for the corresponding dd elements.
+func Dt(args ...any) *h.Node { return Element(a.Dt, args...) }
+
+// Em constructs a new <em> element: stress emphasis.
+func Em(args ...any) *h.Node { return Element(a.Em, args...) }
+
+// Embed constructs a new <embed> element: a plugin.
+func Embed(args ...any) *h.Node { return Element(a.Embed, args...) }
+
+// Fieldset constructs a new <fieldset> element: a group of form controls.
+func Fieldset(args ...any) *h.Node { return Element(a.Fieldset, args...) }
+
+// Figcaption constructs a new <figcaption> element: the caption of a figure.
+func Figcaption(args ...any) *h.Node { return Element(a.Figcaption, args...) }
+
+// Figure constructs a new <figure> element: a figure with an optional caption.
+func Figure(args ...any) *h.Node { return Element(a.Figure, args...) }
+
+// Footer constructs a new <footer> element: the footer of a page or section.
+func Footer(args ...any) *h.Node { return Element(a.Footer, args...) }
+
+// Form constructs a new <form> element: a user-submittable form.
+func Form(args ...any) *h.Node { return Element(a.Form, args...) }
+
+// H1 constructs a new <h1> element: a top-level heading.
+func H1(args ...any) *h.Node { return Element(a.H1, args...) }
+
+// H2 constructs a new <h2> element: a second-level heading.
+func H2(args ...any) *h.Node { return Element(a.H2, args...) }
+
+// H3 constructs a new <h3> element: a third-level heading.
+func H3(args ...any) *h.Node { return Element(a.H3, args...) }
+
+// H4 constructs a new <h4> element: a fourth-level heading.
+func H4(args ...any) *h.Node { return Element(a.H4, args...) }
+
+// H5 constructs a new <h5> element: a fifth-level heading.
+func H5(args ...any) *h.Node { return Element(a.H5, args...) }
+
+// H6 constructs a new <h6> element: a sixth-level heading.
+func H6(args ...any) *h.Node { return Element(a.H6, args...) }
+
+// Head constructs a new <head> element: the container for document metadata.
+func Head(args ...any) *h.Node { return Element(a.Head, args...) }
+
+// Header constructs a new <header> element: introductory or navigational aids for a page or section.
+func Header(args ...any) *h.Node { return Element(a.Header, args...) }
+
+// Hgroup constructs a new <hgroup> element: a heading together with related content.
+func Hgroup(args ...any) *h.Node { return Element(a.Hgroup, args...) }
+
+// Hr constructs a new <hr> element: a thematic break.
+func Hr(args ...any) *h.Node { return Element(a.Hr, args...) }
+
+// Html constructs a new <html> element: the root element.
+func Html(args ...any) *h.Node { return Element(a.Html, args...) }
+
+// I constructs a new <i> element: an alternate voice.
+func I(args ...any) *h.Node { return Element(a.I, args...) }
+
+// Iframe constructs a new <iframe> element: a child navigable.
+func Iframe(args ...any) *h.Node { return Element(a.Iframe, args...) }
+
+// Img constructs a new <img> element: an image.
+func Img(args ...any) *h.Node { return Element(a.Img, args...) }
+
+// Input constructs a new <input> element: a form control.
+func Input(args ...any) *h.Node { return Element(a.Input, args...) }
+
+// Ins constructs a new <ins> element: an addition to the document.
+func Ins(args ...any) *h.Node { return Element(a.Ins, args...) }
+
+// Kbd constructs a new <kbd> element: user input.
+func Kbd(args ...any) *h.Node { return Element(a.Kbd, args...) }
+
+// Label constructs a new <label> element: the caption of a form control.
+func Label(args ...any) *h.Node { return Element(a.Label, args...) }
+
+// Legend constructs a new <legend> element: the caption of a fieldset.
+func Legend(args ...any) *h.Node { return Element(a.Legend, args...) }
+
+// Li constructs a new <li> element: a list item.
+func Li(args ...any) *h.Node { return Element(a.Li, args...) }
+
+// Link constructs a new <link> element: link metadata.
+func Link(args ...any) *h.Node { return Element(a.Link, args...) }
+
+// Main constructs a new <main> element: the container for the dominant contents of the document.
+func Main(args ...any) *h.Node { return Element(a.Main, args...) }
+
+// Map constructs a new <map> element: an image map.
+func Map(args ...any) *h.Node { return Element(a.Map, args...) }
+
+// Mark constructs a new <mark> element: highlighted text.
+func Mark(args ...any) *h.Node { return Element(a.Mark, args...) }
+
+// Menu constructs a new <menu> element: a menu of commands.
+func Menu(args ...any) *h.Node { return Element(a.Menu, args...) }
+
+// Meta constructs a new <meta> element: text metadata.
+func Meta(args ...any) *h.Node { return Element(a.Meta, args...) }
+
+// Meter constructs a new <meter> element: a gauge.
+func Meter(args ...any) *h.Node { return Element(a.Meter, args...) }
+
+// Nav constructs a new <nav> element: a section with navigational links.
+func Nav(args ...any) *h.Node { return Element(a.Nav, args...) }
+
+// Noscript constructs a new <noscript> element: fallback content for when scripting is disabled.
+func Noscript(args ...any) *h.Node { return Element(a.Noscript, args...) }
+
+// Object constructs a new <object> element: an image, child navigable or plugin.
+func Object(args ...any) *h.Node { return Element(a.Object, args...) }
+
+// Ol constructs a new <ol> element: an ordered list.
+func Ol(args ...any) *h.Node { return Element(a.Ol, args...) }
+
+// Optgroup constructs a new <optgroup> element: a group of options in a list box.
+func Optgroup(args ...any) *h.Node { return Element(a.Optgroup, args...) }
+
+// Option constructs a new <option> element: an option in a list box or combo box control.
+func Option(args ...any) *h.Node { return Element(a.Option, args...) }
+
+// Output constructs a new <output> element: a calculated output value.
+func Output(args ...any) *h.Node { return Element(a.Output, args...) }
+
+// P constructs a new <p> element: a paragraph.
+func P(args ...any) *h.Node { return Element(a.P, args...) }
+
+// Picture constructs a new <picture> element: an image with alternative sources.
+func Picture(args ...any) *h.Node { return Element(a.Picture, args...) }
+
+// Pre constructs a new <pre> element: a block of preformatted text.
+func Pre(args ...any) *h.Node { return Element(a.Pre, args...) }
+
+// Progress constructs a new <progress> element: a progress bar.
+func Progress(args ...any) *h.Node { return Element(a.Progress, args...) }
+
+// Q constructs a new <q> element: a quotation.
+func Q(args ...any) *h.Node { return Element(a.Q, args...) }
+
+// Rp constructs a new <rp> element: a parenthesis for ruby annotation text.
+func Rp(args ...any) *h.Node { return Element(a.Rp, args...) }
+
+// Rt constructs a new <rt> element: ruby annotation text.
+func Rt(args ...any) *h.Node { return Element(a.Rt, args...) }
+
+// Ruby constructs a new <ruby> element: ruby annotations.
+func Ruby(args ...any) *h.Node { return Element(a.Ruby, args...) }
+
+// S constructs a new <s> element: inaccurate text.
+func S(args ...any) *h.Node { return Element(a.S, args...) }
+
+// Samp constructs a new <samp> element: computer output.
+func Samp(args ...any) *h.Node { return Element(a.Samp, args...) }
+
+// Script constructs a new <script> element: an embedded script.
+func Script(args ...any) *h.Node { return Element(a.Script, args...) }
+
+// Search constructs a new <search> element: a container for search controls.
+func Search(args ...any) *h.Node { return Element(a.Search, args...) }
+
+// Section constructs a new <section> element: a generic document or application section.
+func Section(args ...any) *h.Node { return Element(a.Section, args...) }
+
+// Select constructs a new <select> element: a list box control.
+func Select(args ...any) *h.Node { return Element(a.Select, args...) }
+
+// Slot constructs a new <slot> element: a shadow tree slot.
+func Slot(args ...any) *h.Node { return Element(a.Slot, args...) }
+
+// Small constructs a new <small> element: a side comment.
+func Small(args ...any) *h.Node { return Element(a.Small, args...) }
+
+// Source constructs a new <source> element: an image source for img or a media source for video or audio.
+func Source(args ...any) *h.Node { return Element(a.Source, args...) }
+
+// Span constructs a new <span> element: a generic phrasing container.
+func Span(args ...any) *h.Node { return Element(a.Span, args...) }
+
+// Strong constructs a new <strong> element: importance.
+func Strong(args ...any) *h.Node { return Element(a.Strong, args...) }
+
+// Style constructs a new <style> element: embedded styling information.
+func Style(args ...any) *h.Node { return Element(a.Style, args...) }
+
+// Sub constructs a new <sub> element: a subscript.
+func Sub(args ...any) *h.Node { return Element(a.Sub, args...) }
+
+// Summary constructs a new <summary> element: the caption of details.
+func Summary(args ...any) *h.Node { return Element(a.Summary, args...) }
+
+// Sup constructs a new <sup> element: a superscript.
+func Sup(args ...any) *h.Node { return Element(a.Sup, args...) }
+
+// Table constructs a new <table> element: a table.
+func Table(args ...any) *h.Node { return Element(a.Table, args...) }
+
+// Tbody constructs a new <tbody> element: a group of rows in a table.
+func Tbody(args ...any) *h.Node { return Element(a.Tbody, args...) }
+
+// Td constructs a new <td> element: a table cell.
+func Td(args ...any) *h.Node { return Element(a.Td, args...) }
+
+// Template constructs a new <template> element: a template.
+func Template(args ...any) *h.Node { return Element(a.Template, args...) }
+
+// Textarea constructs a new <textarea> element: a multiline text control.
+func Textarea(args ...any) *h.Node { return Element(a.Textarea, args...) }
+
+// Tfoot constructs a new <tfoot> element: a group of footer rows in a table.
+func Tfoot(args ...any) *h.Node { return Element(a.Tfoot, args...) }
+
+// Th constructs a new <th> element: a table header cell.
+func Th(args ...any) *h.Node { return Element(a.Th, args...) }
+
+// Thead constructs a new <thead> element: a group of heading rows in a table.
+func Thead(args ...any) *h.Node { return Element(a.Thead, args...) }
+
+// Time constructs a new <time> element: a machine-readable date or time.
+func Time(args ...any) *h.Node { return Element(a.Time, args...) }
+
+// Title constructs a new <title> element: the document title.
+func Title(args ...any) *h.Node { return Element(a.Title, args...) }
+
+// Tr constructs a new <tr> element: a table row.
+func Tr(args ...any) *h.Node { return Element(a.Tr, args...) }
+
+// Track constructs a new <track> element: a timed text track.
+func Track(args ...any) *h.Node { return Element(a.Track, args...) }
+
+// U constructs a new <u> element: an unarticulated annotation.
+func U(args ...any) *h.Node { return Element(a.U, args...) }
+
+// Ul constructs a new <ul> element: a list.
+func Ul(args ...any) *h.Node { return Element(a.Ul, args...) }
+
+// Var constructs a new <var> element: a variable.
+func Var(args ...any) *h.Node { return Element(a.Var, args...) }
+
+// Video constructs a new <video> element: a video player.
+func Video(args ...any) *h.Node { return Element(a.Video, args...) }
+
+// Wbr constructs a new <wbr> element: a line breaking opportunity.
+func Wbr(args ...any) *h.Node { return Element(a.Wbr, args...) }
+
+var categories = map[a.Atom]ContentCategory{
+	a.A:          FlowContent | PhrasingContent | InteractiveContent,
+	a.Abbr:       FlowContent | PhrasingContent,
+	a.Address:    FlowContent,
+	a.Area:       FlowContent | PhrasingContent | VoidElement,
+	a.Article:    FlowContent | SectioningContent,
+	a.Aside:      FlowContent | SectioningContent,
+	a.Audio:      FlowContent | PhrasingContent | EmbeddedContent | InteractiveContent,
+	a.B:          FlowContent | PhrasingContent,
+	a.Base:       MetadataContent | VoidElement,
+	a.Bdi:        FlowContent | PhrasingContent,
+	a.Bdo:        FlowContent | PhrasingContent,
+	a.Blockquote: FlowContent,
+	a.Br:         FlowContent | PhrasingContent | VoidElement,
+	a.Button:     FlowContent | PhrasingContent | InteractiveContent | FormAssociated,
+	a.Canvas:     FlowContent | PhrasingContent | EmbeddedContent,
+	a.Cite:       FlowContent | PhrasingContent,
+	a.Code:       FlowContent | PhrasingContent,
+	a.Col:        VoidElement,
+	a.Data:       FlowContent | PhrasingContent,
+	a.Datalist:   FlowContent | PhrasingContent,
+	a.Del:        FlowContent | PhrasingContent,
+	a.Details:    FlowContent | InteractiveContent,
+	a.Dfn:        FlowContent | PhrasingContent,
+	a.Dialog:     FlowContent,
+	a.Div:        FlowContent,
+	a.Dl:         FlowContent,
+	a.Em:         FlowContent | PhrasingContent,
+	a.Embed:      FlowContent | PhrasingContent | EmbeddedContent | InteractiveContent | VoidElement,
+	a.Fieldset:   FlowContent | FormAssociated,
+	a.Figure:     FlowContent,
+	a.Footer:     FlowContent,
+	a.Form:       FlowContent,
+	a.H1:         FlowContent | HeadingContent,
+	a.H2:         FlowContent | HeadingContent,
+	a.H3:         FlowContent | HeadingContent,
+	a.H4:         FlowContent | HeadingContent,
+	a.H5:         FlowContent | HeadingContent,
+	a.H6:         FlowContent | HeadingContent,
+	a.Header:     FlowContent,
+	a.Hgroup:     FlowContent | HeadingContent,
+	a.Hr:         FlowContent | VoidElement,
+	a.I:          FlowContent | PhrasingContent,
+	a.Iframe:     FlowContent | PhrasingContent | EmbeddedContent | InteractiveContent,
+	a.Img:        FlowContent | PhrasingContent | EmbeddedContent | InteractiveContent | FormAssociated | VoidElement,
+	a.Input:      FlowContent | PhrasingContent | InteractiveContent | FormAssociated | VoidElement,
+	a.Ins:        FlowContent | PhrasingContent,
+	a.Kbd:        FlowContent | PhrasingContent,
+	a.Label:      FlowContent | PhrasingContent | InteractiveContent,
+	a.Link:       MetadataContent | FlowContent | PhrasingContent | VoidElement,
+	a.Main:       FlowContent,
+	a.Map:        FlowContent | PhrasingContent,
+	a.Mark:       FlowContent | PhrasingContent,
+	a.Menu:       FlowContent,
+	a.Meta:       MetadataContent | FlowContent | PhrasingContent | VoidElement,
+	a.Meter:      FlowContent | PhrasingContent | FormAssociated,
+	a.Nav:        FlowContent | SectioningContent,
+	a.Noscript:   MetadataContent | FlowContent | PhrasingContent,
+	a.Object:     FlowContent | PhrasingContent | EmbeddedContent | InteractiveContent | FormAssociated,
+	a.Ol:         FlowContent,
+	a.Output:     FlowContent | PhrasingContent | FormAssociated,
+	a.P:          FlowContent,
+	a.Picture:    FlowContent | PhrasingContent | EmbeddedContent,
+	a.Pre:        FlowContent,
+	a.Progress:   FlowContent | PhrasingContent | FormAssociated,
+	a.Q:          FlowContent | PhrasingContent,
+	a.Ruby:       FlowContent | PhrasingContent,
+	a.S:          FlowContent | PhrasingContent,
+	a.Samp:       FlowContent | PhrasingContent,
+	a.Script:     MetadataContent | FlowContent | PhrasingContent | ScriptSupporting,
+	a.Search:     FlowContent,
+	a.Section:    FlowContent | SectioningContent,
+	a.Select:     FlowContent | PhrasingContent | InteractiveContent | FormAssociated,
+	a.Slot:       FlowContent | PhrasingContent,
+	a.Small:      FlowContent | PhrasingContent,
+	a.Source:     VoidElement,
+	a.Span:       FlowContent | PhrasingContent,
+	a.Strong:     FlowContent | PhrasingContent,
+	a.Style:      MetadataContent,
+	a.Sub:        FlowContent | PhrasingContent,
+	a.Sup:        FlowContent | PhrasingContent,
+	a.Table:      FlowContent,
+	a.Template:   MetadataContent | FlowContent | PhrasingContent | ScriptSupporting,
+	a.Textarea:   FlowContent | PhrasingContent | InteractiveContent | FormAssociated,
+	a.Time:       FlowContent | PhrasingContent,
+	a.Title:      MetadataContent,
+	a.Track:      VoidElement,
+	a.U:          FlowContent | PhrasingContent,
+	a.Ul:         FlowContent,
+	a.Var:        FlowContent | PhrasingContent,
+	a.Video:      FlowContent | PhrasingContent | EmbeddedContent | InteractiveContent,
+	a.Wbr:        FlowContent | PhrasingContent | VoidElement,
+}
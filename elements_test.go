@@ -0,0 +1,29 @@
+package ht
+
+import (
+	"testing"
+
+	a "golang.org/x/net/html/atom"
+)
+
+func TestCategories(t *testing.T) {
+	for tag, c := range categories {
+		if c.Has(VoidElement) != voidElements[tag.String()] {
+			t.Errorf("<%s>: VoidElement is %v, but the renderer disagrees", tag, c.Has(VoidElement))
+		}
+	}
+	if !Categories(a.Span).Has(FlowContent | PhrasingContent) {
+		t.Error("<span> should be flow and phrasing content")
+	}
+	if Categories(a.Div).Has(PhrasingContent) {
+		t.Error("<div> should not be phrasing content")
+	}
+	for _, tag := range []a.Atom{a.Button, a.Input, a.Meter, a.Output, a.Progress, a.Select, a.Textarea} {
+		if !Categories(tag).Has(FormAssociated) {
+			t.Errorf("<%s> is labelable and should be FormAssociated", tag)
+		}
+	}
+	if Categories(a.Li) != 0 {
+		t.Error("<li> should have no categories")
+	}
+}
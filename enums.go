@@ -0,0 +1,262 @@
+package ht
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Attributer is implemented by values that stand for a whole attribute, such
+// as the typed constants below. Element and Apply add the attribute they
+// return, so InputCheckbox can be passed where Type("checkbox") would be.
+type Attributer interface {
+	Attribute() html.Attribute
+}
+
+// InputType is a value of the type attribute of Input.
+type InputType string
+
+const (
+	InputButton        InputType = "button"
+	InputCheckbox      InputType = "checkbox"
+	InputColor         InputType = "color"
+	InputDate          InputType = "date"
+	InputDatetimeLocal InputType = "datetime-local"
+	InputEmail         InputType = "email"
+	InputFile          InputType = "file"
+	InputHidden        InputType = "hidden"
+	InputImage         InputType = "image"
+	InputMonth         InputType = "month"
+	InputNumber        InputType = "number"
+	InputPassword      InputType = "password"
+	InputRadio         InputType = "radio"
+	InputRange         InputType = "range"
+	InputReset         InputType = "reset"
+	InputSearch        InputType = "search"
+	InputSubmit        InputType = "submit"
+	InputTel           InputType = "tel"
+	InputText          InputType = "text"
+	InputTime          InputType = "time"
+	InputURL           InputType = "url"
+	InputWeek          InputType = "week"
+)
+
+func (t InputType) Attribute() html.Attribute { return Attr("type", string(t)) }
+
+// FormMethod is a value of the method attribute of Form.
+type FormMethod string
+
+const (
+	MethodGet    FormMethod = "get"
+	MethodPost   FormMethod = "post"
+	MethodDialog FormMethod = "dialog"
+)
+
+func (m FormMethod) Attribute() html.Attribute { return Attr("method", string(m)) }
+
+// FormEnctype is a value of the enctype attribute of Form.
+type FormEnctype string
+
+const (
+	EnctypeURLEncoded FormEnctype = "application/x-www-form-urlencoded"
+	EnctypeMultipart  FormEnctype = "multipart/form-data"
+	EnctypePlain      FormEnctype = "text/plain"
+)
+
+func (e FormEnctype) Attribute() html.Attribute { return Attr("enctype", string(e)) }
+
+// LinkType is a token of the rel attribute of A, Area, Form and Link. Use
+// Rels to combine several.
+type LinkType string
+
+const (
+	RelAlternate      LinkType = "alternate"
+	RelAuthor         LinkType = "author"
+	RelBookmark       LinkType = "bookmark"
+	RelCanonical      LinkType = "canonical"
+	RelDNSPrefetch    LinkType = "dns-prefetch"
+	RelExternal       LinkType = "external"
+	RelHelp           LinkType = "help"
+	RelIcon           LinkType = "icon"
+	RelLicense        LinkType = "license"
+	RelManifest       LinkType = "manifest"
+	RelMe             LinkType = "me"
+	RelModulePreload  LinkType = "modulepreload"
+	RelNext           LinkType = "next"
+	RelNofollow       LinkType = "nofollow"
+	RelNoopener       LinkType = "noopener"
+	RelNoreferrer     LinkType = "noreferrer"
+	RelOpener         LinkType = "opener"
+	RelPreconnect     LinkType = "preconnect"
+	RelPrefetch       LinkType = "prefetch"
+	RelPreload        LinkType = "preload"
+	RelPrev           LinkType = "prev"
+	RelPrivacyPolicy  LinkType = "privacy-policy"
+	RelSearch         LinkType = "search"
+	RelStylesheet     LinkType = "stylesheet"
+	RelTag            LinkType = "tag"
+	RelTermsOfService LinkType = "terms-of-service"
+)
+
+func (t LinkType) Attribute() html.Attribute { return Attr("rel", string(t)) }
+
+// Rels returns a rel attribute with several link types, e.g.
+// Rels(RelNoopener, RelNoreferrer).
+func Rels(types ...LinkType) html.Attribute { return Attr("rel", joinTokens(types)) }
+
+// TargetName is a value of the target attribute of A, Area, Base and Form.
+// Named browsing contexts are still set with Target.
+type TargetName string
+
+const (
+	TargetSelf   TargetName = "_self"
+	TargetBlank  TargetName = "_blank"
+	TargetParent TargetName = "_parent"
+	TargetTop    TargetName = "_top"
+)
+
+func (t TargetName) Attribute() html.Attribute { return Attr("target", string(t)) }
+
+// AutofillToken is a token of the autocomplete attribute of Input, Select,
+// Textarea and Form. Use Autofill to combine a section, a shipping or billing
+// hint, a contact type and a field name.
+type AutofillToken string
+
+const (
+	AutofillOn  AutofillToken = "on"
+	AutofillOff AutofillToken = "off"
+
+	AutofillShipping AutofillToken = "shipping"
+	AutofillBilling  AutofillToken = "billing"
+
+	AutofillHome   AutofillToken = "home"
+	AutofillWork   AutofillToken = "work"
+	AutofillMobile AutofillToken = "mobile"
+	AutofillFax    AutofillToken = "fax"
+	AutofillPager  AutofillToken = "pager"
+
+	AutofillName                AutofillToken = "name"
+	AutofillHonorificPrefix     AutofillToken = "honorific-prefix"
+	AutofillGivenName           AutofillToken = "given-name"
+	AutofillAdditionalName      AutofillToken = "additional-name"
+	AutofillFamilyName          AutofillToken = "family-name"
+	AutofillHonorificSuffix     AutofillToken = "honorific-suffix"
+	AutofillNickname            AutofillToken = "nickname"
+	AutofillUsername            AutofillToken = "username"
+	AutofillNewPassword         AutofillToken = "new-password"
+	AutofillCurrentPassword     AutofillToken = "current-password"
+	AutofillOneTimeCode         AutofillToken = "one-time-code"
+	AutofillOrganizationTitle   AutofillToken = "organization-title"
+	AutofillOrganization        AutofillToken = "organization"
+	AutofillStreetAddress       AutofillToken = "street-address"
+	AutofillAddressLine1        AutofillToken = "address-line1"
+	AutofillAddressLine2        AutofillToken = "address-line2"
+	AutofillAddressLine3        AutofillToken = "address-line3"
+	AutofillAddressLevel1       AutofillToken = "address-level1"
+	AutofillAddressLevel2       AutofillToken = "address-level2"
+	AutofillAddressLevel3       AutofillToken = "address-level3"
+	AutofillAddressLevel4       AutofillToken = "address-level4"
+	AutofillCountry             AutofillToken = "country"
+	AutofillCountryName         AutofillToken = "country-name"
+	AutofillPostalCode          AutofillToken = "postal-code"
+	AutofillCCName              AutofillToken = "cc-name"
+	AutofillCCGivenName         AutofillToken = "cc-given-name"
+	AutofillCCAdditionalName    AutofillToken = "cc-additional-name"
+	AutofillCCFamilyName        AutofillToken = "cc-family-name"
+	AutofillCCNumber            AutofillToken = "cc-number"
+	AutofillCCExp               AutofillToken = "cc-exp"
+	AutofillCCExpMonth          AutofillToken = "cc-exp-month"
+	AutofillCCExpYear           AutofillToken = "cc-exp-year"
+	AutofillCCCSC               AutofillToken = "cc-csc"
+	AutofillCCType              AutofillToken = "cc-type"
+	AutofillTransactionCurrency AutofillToken = "transaction-currency"
+	AutofillTransactionAmount   AutofillToken = "transaction-amount"
+	AutofillLanguage            AutofillToken = "language"
+	AutofillBday                AutofillToken = "bday"
+	AutofillBdayDay             AutofillToken = "bday-day"
+	AutofillBdayMonth           AutofillToken = "bday-month"
+	AutofillBdayYear            AutofillToken = "bday-year"
+	AutofillSex                 AutofillToken = "sex"
+	AutofillURL                 AutofillToken = "url"
+	AutofillPhoto               AutofillToken = "photo"
+	AutofillTel                 AutofillToken = "tel"
+	AutofillTelCountryCode      AutofillToken = "tel-country-code"
+	AutofillTelNational         AutofillToken = "tel-national"
+	AutofillTelAreaCode         AutofillToken = "tel-area-code"
+	AutofillTelLocal            AutofillToken = "tel-local"
+	AutofillTelExtension        AutofillToken = "tel-extension"
+	AutofillEmail               AutofillToken = "email"
+	AutofillIMPP                AutofillToken = "impp"
+
+	// AutofillWebauthn marks a field for passkey suggestions. It goes
+	// last, after a username or current-password token.
+	AutofillWebauthn AutofillToken = "webauthn"
+)
+
+func (t AutofillToken) Attribute() html.Attribute { return Attr("autocomplete", string(t)) }
+
+// AutofillSection returns the token that groups fields into a named section,
+// such as two addresses on one form.
+func AutofillSection(name string) AutofillToken { return AutofillToken("section-" + name) }
+
+// Autofill returns an autocomplete attribute with several tokens, e.g.
+// Autofill(AutofillShipping, AutofillStreetAddress).
+func Autofill(tokens ...AutofillToken) html.Attribute {
+	return Attr("autocomplete", joinTokens(tokens))
+}
+
+// LoadingMode is a value of the loading attribute of Img and Iframe.
+type LoadingMode string
+
+const (
+	LoadingEager LoadingMode = "eager"
+	LoadingLazy  LoadingMode = "lazy"
+)
+
+func (m LoadingMode) Attribute() html.Attribute { return Attr("loading", string(m)) }
+
+// Referrer is a value of the referrerpolicy attribute.
+type Referrer string
+
+const (
+	ReferrerNoReferrer                  Referrer = "no-referrer"
+	ReferrerNoReferrerWhenDowngrade     Referrer = "no-referrer-when-downgrade"
+	ReferrerOrigin                      Referrer = "origin"
+	ReferrerOriginWhenCrossOrigin       Referrer = "origin-when-cross-origin"
+	ReferrerSameOrigin                  Referrer = "same-origin"
+	ReferrerStrictOrigin                Referrer = "strict-origin"
+	ReferrerStrictOriginWhenCrossOrigin Referrer = "strict-origin-when-cross-origin"
+	ReferrerUnsafeURL                   Referrer = "unsafe-url"
+)
+
+func (p Referrer) Attribute() html.Attribute { return Attr("referrerpolicy", string(p)) }
+
+// CORSMode is a value of the crossorigin attribute.
+type CORSMode string
+
+const (
+	CrossOriginAnonymous      CORSMode = "anonymous"
+	CrossOriginUseCredentials CORSMode = "use-credentials"
+)
+
+func (m CORSMode) Attribute() html.Attribute { return Attr("crossorigin", string(m)) }
+
+// Direction is a value of the dir attribute.
+type Direction string
+
+const (
+	DirLTR  Direction = "ltr"
+	DirRTL  Direction = "rtl"
+	DirAuto Direction = "auto"
+)
+
+func (d Direction) Attribute() html.Attribute { return Attr("dir", string(d)) }
+
+// joinTokens joins string-typed tokens with spaces.
+func joinTokens[T ~string](tokens []T) string {
+	s := make([]string, len(tokens))
+	for i, t := range tokens {
+		s[i] = string(t)
+	}
+	return strings.Join(s, " ")
+}
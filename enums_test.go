@@ -0,0 +1,28 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestEnums(t *testing.T) {
+	form := Form(MethodPost, EnctypeMultipart, Autofill(AutofillSection("ship"), AutofillShipping, AutofillPostalCode),
+		Input(InputCheckbox, Name("ok")),
+		A(Href("/x"), TargetBlank, Rels(RelNoopener, RelNoreferrer), ReferrerNoReferrer),
+		Img(LoadingLazy, CrossOriginAnonymous, DirRTL),
+		Input(InputText, Type("email")),
+	)
+	want := `<form method="post" enctype="multipart/form-data" autocomplete="section-ship shipping postal-code">` +
+		`<input type="checkbox" name="ok"/>` +
+		`<a href="/x" target="_blank" rel="noopener noreferrer" referrerpolicy="no-referrer"></a>` +
+		`<img loading="lazy" crossorigin="anonymous" dir="rtl"/>` +
+		`<input type="email"/></form>`
+	var b strings.Builder
+	if err := Render(context.Background(), &b, form); err != nil {
+		t.Fatal(err)
+	}
+	if stripSources(b.String()) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stripSources(b.String()), want)
+	}
+}
@@ -0,0 +1,49 @@
+package ht
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang.org/x/net/html"
+)
+
+// On sets the on<event> handler attribute, e.g. On("click", ...) sets onclick.
+// OnClick, OnSubmit and the other generated helpers do the same for each
+// standard event. The handler is built with JS, so Go values can be passed
+// in safely:
+//
+//	Button(OnClick("select(%v, %v)", item.ID, item.Name), Text("Select"))
+func On(event, js string, args ...any) html.Attribute { return Attr("on"+event, JS(js, args...)) }
+
+// JS formats a JavaScript snippet, replacing each verb in format (%v, %s,
+// %d, ...) with the corresponding argument encoded as JSON. Strings become
+// quoted string literals, maps and structs become objects, and nil becomes
+// null. Like html/template, characters such as <, > and & are escaped, so the
+// result is safe inside attributes and <script> elements alike. A value that
+// cannot be encoded is written as null.
+//
+// As with fmt, every other % in format starts a verb, so a literal percent
+// sign, such as JavaScript's remainder operator or a CSS percentage, must be
+// written %%: JS("i %% %v === 0", n). With no args, format is returned as is
+// and % needs no escaping.
+func JS(format string, args ...any) string {
+	if len(args) == 0 {
+		return format
+	}
+	vs := make([]any, len(args))
+	for i, arg := range args {
+		vs[i] = jsValue{arg}
+	}
+	return fmt.Sprintf(format, vs...)
+}
+
+// jsValue formats as its value encoded as JSON, whatever the verb.
+type jsValue struct{ v any }
+
+func (j jsValue) Format(f fmt.State, _ rune) {
+	b, err := json.Marshal(j.v)
+	if err != nil {
+		b = []byte("null")
+	}
+	f.Write(b)
+}
@@ -0,0 +1,371 @@
+// Code generated by "go run ./internal/gen"; DO NOT EDIT.
+
+package ht
+
+import "golang.org/x/net/html"
+
+// OnAbort sets the onabort event handler attribute. See On.
+func OnAbort(js string, args ...any) html.Attribute { return On("abort", js, args...) }
+
+// OnAfterprint sets the onafterprint event handler attribute. See On.
+func OnAfterprint(js string, args ...any) html.Attribute { return On("afterprint", js, args...) }
+
+// OnAnimationcancel sets the onanimationcancel event handler attribute. See On.
+func OnAnimationcancel(js string, args ...any) html.Attribute {
+	return On("animationcancel", js, args...)
+}
+
+// OnAnimationend sets the onanimationend event handler attribute. See On.
+func OnAnimationend(js string, args ...any) html.Attribute { return On("animationend", js, args...) }
+
+// OnAnimationiteration sets the onanimationiteration event handler attribute. See On.
+func OnAnimationiteration(js string, args ...any) html.Attribute {
+	return On("animationiteration", js, args...)
+}
+
+// OnAnimationstart sets the onanimationstart event handler attribute. See On.
+func OnAnimationstart(js string, args ...any) html.Attribute {
+	return On("animationstart", js, args...)
+}
+
+// OnAuxclick sets the onauxclick event handler attribute. See On.
+func OnAuxclick(js string, args ...any) html.Attribute { return On("auxclick", js, args...) }
+
+// OnBeforeinput sets the onbeforeinput event handler attribute. See On.
+func OnBeforeinput(js string, args ...any) html.Attribute { return On("beforeinput", js, args...) }
+
+// OnBeforematch sets the onbeforematch event handler attribute. See On.
+func OnBeforematch(js string, args ...any) html.Attribute { return On("beforematch", js, args...) }
+
+// OnBeforeprint sets the onbeforeprint event handler attribute. See On.
+func OnBeforeprint(js string, args ...any) html.Attribute { return On("beforeprint", js, args...) }
+
+// OnBeforetoggle sets the onbeforetoggle event handler attribute. See On.
+func OnBeforetoggle(js string, args ...any) html.Attribute { return On("beforetoggle", js, args...) }
+
+// OnBeforeunload sets the onbeforeunload event handler attribute. See On.
+func OnBeforeunload(js string, args ...any) html.Attribute { return On("beforeunload", js, args...) }
+
+// OnBlur sets the onblur event handler attribute. See On.
+func OnBlur(js string, args ...any) html.Attribute { return On("blur", js, args...) }
+
+// OnCancel sets the oncancel event handler attribute. See On.
+func OnCancel(js string, args ...any) html.Attribute { return On("cancel", js, args...) }
+
+// OnCanplay sets the oncanplay event handler attribute. See On.
+func OnCanplay(js string, args ...any) html.Attribute { return On("canplay", js, args...) }
+
+// OnCanplaythrough sets the oncanplaythrough event handler attribute. See On.
+func OnCanplaythrough(js string, args ...any) html.Attribute {
+	return On("canplaythrough", js, args...)
+}
+
+// OnChange sets the onchange event handler attribute. See On.
+func OnChange(js string, args ...any) html.Attribute { return On("change", js, args...) }
+
+// OnClick sets the onclick event handler attribute. See On.
+func OnClick(js string, args ...any) html.Attribute { return On("click", js, args...) }
+
+// OnClose sets the onclose event handler attribute. See On.
+func OnClose(js string, args ...any) html.Attribute { return On("close", js, args...) }
+
+// OnContextlost sets the oncontextlost event handler attribute. See On.
+func OnContextlost(js string, args ...any) html.Attribute { return On("contextlost", js, args...) }
+
+// OnContextmenu sets the oncontextmenu event handler attribute. See On.
+func OnContextmenu(js string, args ...any) html.Attribute { return On("contextmenu", js, args...) }
+
+// OnContextrestored sets the oncontextrestored event handler attribute. See On.
+func OnContextrestored(js string, args ...any) html.Attribute {
+	return On("contextrestored", js, args...)
+}
+
+// OnCopy sets the oncopy event handler attribute. See On.
+func OnCopy(js string, args ...any) html.Attribute { return On("copy", js, args...) }
+
+// OnCuechange sets the oncuechange event handler attribute. See On.
+func OnCuechange(js string, args ...any) html.Attribute { return On("cuechange", js, args...) }
+
+// OnCut sets the oncut event handler attribute. See On.
+func OnCut(js string, args ...any) html.Attribute { return On("cut", js, args...) }
+
+// OnDblclick sets the ondblclick event handler attribute. See On.
+func OnDblclick(js string, args ...any) html.Attribute { return On("dblclick", js, args...) }
+
+// OnDrag sets the ondrag event handler attribute. See On.
+func OnDrag(js string, args ...any) html.Attribute { return On("drag", js, args...) }
+
+// OnDragend sets the ondragend event handler attribute. See On.
+func OnDragend(js string, args ...any) html.Attribute { return On("dragend", js, args...) }
+
+// OnDragenter sets the ondragenter event handler attribute. See On.
+func OnDragenter(js string, args ...any) html.Attribute { return On("dragenter", js, args...) }
+
+// OnDragleave sets the ondragleave event handler attribute. See On.
+func OnDragleave(js string, args ...any) html.Attribute { return On("dragleave", js, args...) }
+
+// OnDragover sets the ondragover event handler attribute. See On.
+func OnDragover(js string, args ...any) html.Attribute { return On("dragover", js, args...) }
+
+// OnDragstart sets the ondragstart event handler attribute. See On.
+func OnDragstart(js string, args ...any) html.Attribute { return On("dragstart", js, args...) }
+
+// OnDrop sets the ondrop event handler attribute. See On.
+func OnDrop(js string, args ...any) html.Attribute { return On("drop", js, args...) }
+
+// OnDurationchange sets the ondurationchange event handler attribute. See On.
+func OnDurationchange(js string, args ...any) html.Attribute {
+	return On("durationchange", js, args...)
+}
+
+// OnEmptied sets the onemptied event handler attribute. See On.
+func OnEmptied(js string, args ...any) html.Attribute { return On("emptied", js, args...) }
+
+// OnEnded sets the onended event handler attribute. See On.
+func OnEnded(js string, args ...any) html.Attribute { return On("ended", js, args...) }
+
+// OnErrorAttr sets the onerror event handler attribute. See On.
+func OnErrorAttr(js string, args ...any) html.Attribute { return On("error", js, args...) }
+
+// OnFocus sets the onfocus event handler attribute. See On.
+func OnFocus(js string, args ...any) html.Attribute { return On("focus", js, args...) }
+
+// OnFocusin sets the onfocusin event handler attribute. See On.
+func OnFocusin(js string, args ...any) html.Attribute { return On("focusin", js, args...) }
+
+// OnFocusout sets the onfocusout event handler attribute. See On.
+func OnFocusout(js string, args ...any) html.Attribute { return On("focusout", js, args...) }
+
+// OnFormdata sets the onformdata event handler attribute. See On.
+func OnFormdata(js string, args ...any) html.Attribute { return On("formdata", js, args...) }
+
+// OnGotpointercapture sets the ongotpointercapture event handler attribute. See On.
+func OnGotpointercapture(js string, args ...any) html.Attribute {
+	return On("gotpointercapture", js, args...)
+}
+
+// OnHashchange sets the onhashchange event handler attribute. See On.
+func OnHashchange(js string, args ...any) html.Attribute { return On("hashchange", js, args...) }
+
+// OnInput sets the oninput event handler attribute. See On.
+func OnInput(js string, args ...any) html.Attribute { return On("input", js, args...) }
+
+// OnInvalid sets the oninvalid event handler attribute. See On.
+func OnInvalid(js string, args ...any) html.Attribute { return On("invalid", js, args...) }
+
+// OnKeydown sets the onkeydown event handler attribute. See On.
+func OnKeydown(js string, args ...any) html.Attribute { return On("keydown", js, args...) }
+
+// OnKeypress sets the onkeypress event handler attribute. See On.
+func OnKeypress(js string, args ...any) html.Attribute { return On("keypress", js, args...) }
+
+// OnKeyup sets the onkeyup event handler attribute. See On.
+func OnKeyup(js string, args ...any) html.Attribute { return On("keyup", js, args...) }
+
+// OnLanguagechange sets the onlanguagechange event handler attribute. See On.
+func OnLanguagechange(js string, args ...any) html.Attribute {
+	return On("languagechange", js, args...)
+}
+
+// OnLoad sets the onload event handler attribute. See On.
+func OnLoad(js string, args ...any) html.Attribute { return On("load", js, args...) }
+
+// OnLoadeddata sets the onloadeddata event handler attribute. See On.
+func OnLoadeddata(js string, args ...any) html.Attribute { return On("loadeddata", js, args...) }
+
+// OnLoadedmetadata sets the onloadedmetadata event handler attribute. See On.
+func OnLoadedmetadata(js string, args ...any) html.Attribute {
+	return On("loadedmetadata", js, args...)
+}
+
+// OnLoadstart sets the onloadstart event handler attribute. See On.
+func OnLoadstart(js string, args ...any) html.Attribute { return On("loadstart", js, args...) }
+
+// OnLostpointercapture sets the onlostpointercapture event handler attribute. See On.
+func OnLostpointercapture(js string, args ...any) html.Attribute {
+	return On("lostpointercapture", js, args...)
+}
+
+// OnMessage sets the onmessage event handler attribute. See On.
+func OnMessage(js string, args ...any) html.Attribute { return On("message", js, args...) }
+
+// OnMessageerror sets the onmessageerror event handler attribute. See On.
+func OnMessageerror(js string, args ...any) html.Attribute { return On("messageerror", js, args...) }
+
+// OnMousedown sets the onmousedown event handler attribute. See On.
+func OnMousedown(js string, args ...any) html.Attribute { return On("mousedown", js, args...) }
+
+// OnMouseenter sets the onmouseenter event handler attribute. See On.
+func OnMouseenter(js string, args ...any) html.Attribute { return On("mouseenter", js, args...) }
+
+// OnMouseleave sets the onmouseleave event handler attribute. See On.
+func OnMouseleave(js string, args ...any) html.Attribute { return On("mouseleave", js, args...) }
+
+// OnMousemove sets the onmousemove event handler attribute. See On.
+func OnMousemove(js string, args ...any) html.Attribute { return On("mousemove", js, args...) }
+
+// OnMouseout sets the onmouseout event handler attribute. See On.
+func OnMouseout(js string, args ...any) html.Attribute { return On("mouseout", js, args...) }
+
+// OnMouseover sets the onmouseover event handler attribute. See On.
+func OnMouseover(js string, args ...any) html.Attribute { return On("mouseover", js, args...) }
+
+// OnMouseup sets the onmouseup event handler attribute. See On.
+func OnMouseup(js string, args ...any) html.Attribute { return On("mouseup", js, args...) }
+
+// OnOffline sets the onoffline event handler attribute. See On.
+func OnOffline(js string, args ...any) html.Attribute { return On("offline", js, args...) }
+
+// OnOnline sets the ononline event handler attribute. See On.
+func OnOnline(js string, args ...any) html.Attribute { return On("online", js, args...) }
+
+// OnPagehide sets the onpagehide event handler attribute. See On.
+func OnPagehide(js string, args ...any) html.Attribute { return On("pagehide", js, args...) }
+
+// OnPagereveal sets the onpagereveal event handler attribute. See On.
+func OnPagereveal(js string, args ...any) html.Attribute { return On("pagereveal", js, args...) }
+
+// OnPageshow sets the onpageshow event handler attribute. See On.
+func OnPageshow(js string, args ...any) html.Attribute { return On("pageshow", js, args...) }
+
+// OnPageswap sets the onpageswap event handler attribute. See On.
+func OnPageswap(js string, args ...any) html.Attribute { return On("pageswap", js, args...) }
+
+// OnPaste sets the onpaste event handler attribute. See On.
+func OnPaste(js string, args ...any) html.Attribute { return On("paste", js, args...) }
+
+// OnPause sets the onpause event handler attribute. See On.
+func OnPause(js string, args ...any) html.Attribute { return On("pause", js, args...) }
+
+// OnPlay sets the onplay event handler attribute. See On.
+func OnPlay(js string, args ...any) html.Attribute { return On("play", js, args...) }
+
+// OnPlaying sets the onplaying event handler attribute. See On.
+func OnPlaying(js string, args ...any) html.Attribute { return On("playing", js, args...) }
+
+// OnPointercancel sets the onpointercancel event handler attribute. See On.
+func OnPointercancel(js string, args ...any) html.Attribute { return On("pointercancel", js, args...) }
+
+// OnPointerdown sets the onpointerdown event handler attribute. See On.
+func OnPointerdown(js string, args ...any) html.Attribute { return On("pointerdown", js, args...) }
+
+// OnPointerenter sets the onpointerenter event handler attribute. See On.
+func OnPointerenter(js string, args ...any) html.Attribute { return On("pointerenter", js, args...) }
+
+// OnPointerleave sets the onpointerleave event handler attribute. See On.
+func OnPointerleave(js string, args ...any) html.Attribute { return On("pointerleave", js, args...) }
+
+// OnPointermove sets the onpointermove event handler attribute. See On.
+func OnPointermove(js string, args ...any) html.Attribute { return On("pointermove", js, args...) }
+
+// OnPointerout sets the onpointerout event handler attribute. See On.
+func OnPointerout(js string, args ...any) html.Attribute { return On("pointerout", js, args...) }
+
+// OnPointerover sets the onpointerover event handler attribute. See On.
+func OnPointerover(js string, args ...any) html.Attribute { return On("pointerover", js, args...) }
+
+// OnPointerup sets the onpointerup event handler attribute. See On.
+func OnPointerup(js string, args ...any) html.Attribute { return On("pointerup", js, args...) }
+
+// OnPopstate sets the onpopstate event handler attribute. See On.
+func OnPopstate(js string, args ...any) html.Attribute { return On("popstate", js, args...) }
+
+// OnProgress sets the onprogress event handler attribute. See On.
+func OnProgress(js string, args ...any) html.Attribute { return On("progress", js, args...) }
+
+// OnRatechange sets the onratechange event handler attribute. See On.
+func OnRatechange(js string, args ...any) html.Attribute { return On("ratechange", js, args...) }
+
+// OnRejectionhandled sets the onrejectionhandled event handler attribute. See On.
+func OnRejectionhandled(js string, args ...any) html.Attribute {
+	return On("rejectionhandled", js, args...)
+}
+
+// OnReset sets the onreset event handler attribute. See On.
+func OnReset(js string, args ...any) html.Attribute { return On("reset", js, args...) }
+
+// OnResize sets the onresize event handler attribute. See On.
+func OnResize(js string, args ...any) html.Attribute { return On("resize", js, args...) }
+
+// OnScroll sets the onscroll event handler attribute. See On.
+func OnScroll(js string, args ...any) html.Attribute { return On("scroll", js, args...) }
+
+// OnScrollend sets the onscrollend event handler attribute. See On.
+func OnScrollend(js string, args ...any) html.Attribute { return On("scrollend", js, args...) }
+
+// OnSecuritypolicyviolation sets the onsecuritypolicyviolation event handler attribute. See On.
+func OnSecuritypolicyviolation(js string, args ...any) html.Attribute {
+	return On("securitypolicyviolation", js, args...)
+}
+
+// OnSeeked sets the onseeked event handler attribute. See On.
+func OnSeeked(js string, args ...any) html.Attribute { return On("seeked", js, args...) }
+
+// OnSeeking sets the onseeking event handler attribute. See On.
+func OnSeeking(js string, args ...any) html.Attribute { return On("seeking", js, args...) }
+
+// OnSelect sets the onselect event handler attribute. See On.
+func OnSelect(js string, args ...any) html.Attribute { return On("select", js, args...) }
+
+// OnSlotchange sets the onslotchange event handler attribute. See On.
+func OnSlotchange(js string, args ...any) html.Attribute { return On("slotchange", js, args...) }
+
+// OnStalled sets the onstalled event handler attribute. See On.
+func OnStalled(js string, args ...any) html.Attribute { return On("stalled", js, args...) }
+
+// OnStorage sets the onstorage event handler attribute. See On.
+func OnStorage(js string, args ...any) html.Attribute { return On("storage", js, args...) }
+
+// OnSubmit sets the onsubmit event handler attribute. See On.
+func OnSubmit(js string, args ...any) html.Attribute { return On("submit", js, args...) }
+
+// OnSuspend sets the onsuspend event handler attribute. See On.
+func OnSuspend(js string, args ...any) html.Attribute { return On("suspend", js, args...) }
+
+// OnTimeupdate sets the ontimeupdate event handler attribute. See On.
+func OnTimeupdate(js string, args ...any) html.Attribute { return On("timeupdate", js, args...) }
+
+// OnToggle sets the ontoggle event handler attribute. See On.
+func OnToggle(js string, args ...any) html.Attribute { return On("toggle", js, args...) }
+
+// OnTouchcancel sets the ontouchcancel event handler attribute. See On.
+func OnTouchcancel(js string, args ...any) html.Attribute { return On("touchcancel", js, args...) }
+
+// OnTouchend sets the ontouchend event handler attribute. See On.
+func OnTouchend(js string, args ...any) html.Attribute { return On("touchend", js, args...) }
+
+// OnTouchmove sets the ontouchmove event handler attribute. See On.
+func OnTouchmove(js string, args ...any) html.Attribute { return On("touchmove", js, args...) }
+
+// OnTouchstart sets the ontouchstart event handler attribute. See On.
+func OnTouchstart(js string, args ...any) html.Attribute { return On("touchstart", js, args...) }
+
+// OnTransitioncancel sets the ontransitioncancel event handler attribute. See On.
+func OnTransitioncancel(js string, args ...any) html.Attribute {
+	return On("transitioncancel", js, args...)
+}
+
+// OnTransitionend sets the ontransitionend event handler attribute. See On.
+func OnTransitionend(js string, args ...any) html.Attribute { return On("transitionend", js, args...) }
+
+// OnTransitionrun sets the ontransitionrun event handler attribute. See On.
+func OnTransitionrun(js string, args ...any) html.Attribute { return On("transitionrun", js, args...) }
+
+// OnTransitionstart sets the ontransitionstart event handler attribute. See On.
+func OnTransitionstart(js string, args ...any) html.Attribute {
+	return On("transitionstart", js, args...)
+}
+
+// OnUnhandledrejection sets the onunhandledrejection event handler attribute. See On.
+func OnUnhandledrejection(js string, args ...any) html.Attribute {
+	return On("unhandledrejection", js, args...)
+}
+
+// OnVolumechange sets the onvolumechange event handler attribute. See On.
+func OnVolumechange(js string, args ...any) html.Attribute { return On("volumechange", js, args...) }
+
+// OnWaiting sets the onwaiting event handler attribute. See On.
+func OnWaiting(js string, args ...any) html.Attribute { return On("waiting", js, args...) }
+
+// OnWheel sets the onwheel event handler attribute. See On.
+func OnWheel(js string, args ...any) html.Attribute { return On("wheel", js, args...) }
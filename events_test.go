@@ -0,0 +1,38 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestEventHandlers(t *testing.T) {
+	name := `</script><script>alert("x")</script>`
+	for _, tt := range []struct {
+		attr html.Attribute
+		want string
+	}{
+		{OnClick("select(%v, %v)", 7, name), `select(7, "\u003c/script\u003e\u003cscript\u003ealert(\"x\")\u003c/script\u003e")`},
+		{On("submit", "send(%s)", map[string]any{"a": []int{1, 2}, "b": nil}), `send({"a":[1,2],"b":null})`},
+		{OnErrorAttr("this.src = %q", "/fallback.png"), `this.src = "/fallback.png"`},
+		{OnInput("n = n % 2"), `n = n % 2`},
+		{OnInput("n = n %% %v; w = '50%%'", 3), `n = n % 3; w = '50%'`},
+		{XOn("click", "open = %v", true), `open = true`},
+		{HxOn("htmx:after-request", "log(%v)", "a'b & c"), `log("a'b \u0026 c")`},
+		{OnChange("f(%v)", func() {}), `f(null)`},
+	} {
+		if tt.attr.Val != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.attr.Key, tt.attr.Val, tt.want)
+		}
+	}
+
+	var b strings.Builder
+	if err := Render(context.Background(), &b, Button(OnClick("go(%v)", `"&'`))); err != nil {
+		t.Fatal(err)
+	}
+	if want := `<button onclick="go(&#34;\&#34;\u0026&#39;&#34;)"></button>`; stripSources(b.String()) != want {
+		t.Errorf("got %s, want %s", stripSources(b.String()), want)
+	}
+}
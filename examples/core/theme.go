@@ -5,54 +5,64 @@ import (
 	h "golang.org/x/net/html"
 )
 
+// themeSwitcher is identical on every page, so it is only rendered once.
+var themeSwitcher = Static(buildThemeSwitcher)
+
 // ThemeSwitcher returns a daisyUI-based theme switcher dropdown component.
 // It displays a dropdown with all available themes and handles theme synchronization.
 func ThemeSwitcher() *h.Node {
-	xData := `{
-		theme: localStorage.getItem('theme') || 'light',
-		themes: [
-			{value: 'light', label: '☀️ Light'},
-			{value: 'dark', label: '🌙 Dark'},
-			{value: 'cupcake', label: '🧁 Cupcake'},
-			{value: 'bumblebee', label: '🐝 Bumblebee'},
-			{value: 'emerald', label: '❇️ Emerald'},
-			{value: 'corporate', label: '💼 Corporate'},
-			{value: 'synthwave', label: '🌌 Synthwave'},
-			{value: 'retro', label: '📻 Retro'},
-			{value: 'cyberpunk', label: '🤖 Cyberpunk'},
-			{value: 'valentine', label: '💕 Valentine'},
-			{value: 'halloween', label: '🎃 Halloween'},
-			{value: 'garden', label: '🌷 Garden'},
-			{value: 'forest', label: '🌲 Forest'},
-			{value: 'aqua', label: '🌊 Aqua'},
-			{value: 'lofi', label: '🎧 Lofi'},
-			{value: 'pastel', label: '🌸 Pastel'},
-			{value: 'fantasy', label: '🧙 Fantasy'},
-			{value: 'wireframe', label: '📐 Wireframe'},
-			{value: 'black', label: '🖤 Black'},
-			{value: 'luxury', label: '💎 Luxury'},
-			{value: 'dracula', label: '🧛 Dracula'},
-			{value: 'cmyk', label: '🎨 CMYK'},
-			{value: 'autumn', label: '🍁 Autumn'},
-			{value: 'business', label: '📈 Business'},
-			{value: 'acid', label: '🧪 Acid'},
-			{value: 'lemonade', label: '🍋 Lemonade'},
-			{value: 'night', label: '🌃 Night'},
-			{value: 'coffee', label: '☕ Coffee'},
-			{value: 'winter', label: '❄️ Winter'},
-			{value: 'dim', label: '🔅 Dim'},
-			{value: 'nord', label: '🏔️ Nord'},
-			{value: 'sunset', label: '🌇 Sunset'},
-			{value: 'caramellatte', label: '🍮 Caramellatte'},
-			{value: 'abyss', label: '🌑 Abyss'},
-			{value: 'silk', label: '🪷 Silk'}
-		]
-	}`
+	return themeSwitcher.Node()
+}
+
+// theme is one entry of the theme switcher.
+type theme struct {
+	Value string `json:"value"`
+	Label string `json:"label"`
+}
+
+var themes = []theme{
+	{"light", "☀️ Light"},
+	{"dark", "🌙 Dark"},
+	{"cupcake", "🧁 Cupcake"},
+	{"bumblebee", "🐝 Bumblebee"},
+	{"emerald", "❇️ Emerald"},
+	{"corporate", "💼 Corporate"},
+	{"synthwave", "🌌 Synthwave"},
+	{"retro", "📻 Retro"},
+	{"cyberpunk", "🤖 Cyberpunk"},
+	{"valentine", "💕 Valentine"},
+	{"halloween", "🎃 Halloween"},
+	{"garden", "🌷 Garden"},
+	{"forest", "🌲 Forest"},
+	{"aqua", "🌊 Aqua"},
+	{"lofi", "🎧 Lofi"},
+	{"pastel", "🌸 Pastel"},
+	{"fantasy", "🧙 Fantasy"},
+	{"wireframe", "📐 Wireframe"},
+	{"black", "🖤 Black"},
+	{"luxury", "💎 Luxury"},
+	{"dracula", "🧛 Dracula"},
+	{"cmyk", "🎨 CMYK"},
+	{"autumn", "🍁 Autumn"},
+	{"business", "📈 Business"},
+	{"acid", "🧪 Acid"},
+	{"lemonade", "🍋 Lemonade"},
+	{"night", "🌃 Night"},
+	{"coffee", "☕ Coffee"},
+	{"winter", "❄️ Winter"},
+	{"dim", "🔅 Dim"},
+	{"nord", "🏔️ Nord"},
+	{"sunset", "🌇 Sunset"},
+	{"caramellatte", "🍮 Caramellatte"},
+	{"abyss", "🌑 Abyss"},
+	{"silk", "🪷 Silk"},
+}
 
+func buildThemeSwitcher() *h.Node {
 	return Div(
 		Class("dropdown dropdown-end"),
-		X("data", xData),
-		X("init", "document.documentElement.setAttribute('data-theme', theme); $watch('theme', val => { localStorage.setItem('theme', val); document.documentElement.setAttribute('data-theme', val) })"),
+		XData(map[string]any{"theme": "light", "themes": themes}),
+		XInit("theme = localStorage.getItem('theme') || theme; document.documentElement.setAttribute('data-theme', theme); $watch('theme', val => { localStorage.setItem('theme', val); document.documentElement.setAttribute('data-theme', val) })"),
 		Div(
 			Class("btn btn-ghost rounded-field"),
 			Tabindex("0"),
@@ -62,7 +72,7 @@ func ThemeSwitcher() *h.Node {
 		Ul(
 			Class("menu dropdown-content bg-base-200 rounded-box z-1 mt-4 w-56 p-2 shadow-sm h-[30.5rem] flex-nowrap overflow-y-auto *:w-full"),
 			Template(
-				X("for", "t in themes"),
+				XFor("t in themes"),
 				Li(
 					Input(
 						Type("radio"),
@@ -70,7 +80,7 @@ func ThemeSwitcher() *h.Node {
 						Class("theme-controller btn btn-sm btn-ghost justify-start"),
 						XBind("aria-label", "t.label"),
 						XBind("value", "t.value"),
-						X("model", "theme"),
+						XModel("theme"),
 					),
 				),
 			),
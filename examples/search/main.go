@@ -79,7 +79,7 @@ func (a *App) renderSearchBox() *html.Node {
 			Placeholder("Search movies by title or director..."),
 			Class("grow"),
 			HxGet(a.Prefix+"/results"),
-			HxTrigger("input changed delay:500ms, search"),
+			Triggers(Trigger("input").Changed().Delay(500*time.Millisecond), Trigger("search")),
 			HxTarget("#"+a.resultsID()),
 			HxIndicator("#"+a.indicatorID()),
 		),
@@ -142,7 +142,7 @@ func (a *App) handleResults(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Render just the rows to update the tbody
-	_ = html.Render(w, Fragment(a.renderRows(results)))
+	_ = Render(r.Context(), w, Fragment(a.renderRows(results)))
 }
 
 func main() {
@@ -194,7 +194,7 @@ func main() {
 		)
 
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		if err := html.Render(w, page); err != nil {
+		if err := Render(r.Context(), w, page); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
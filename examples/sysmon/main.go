@@ -110,7 +110,7 @@ func StartMetricsEngine(ctx context.Context, b *Broker) {
 				node := renderMetrics(goroutines, memMB, heapObjects, numGC)
 
 				var buf bytes.Buffer
-				_ = html.Render(&buf, node)
+				_ = Render(ctx, &buf, node)
 
 				// Build SSE payload with proper multi-line data framing.
 				// Each line of the HTML is prefixed with "data: " so the SSE
@@ -249,8 +249,8 @@ func main() {
 					),
 					Div(
 						Class("p-4 flex flex-col gap-8 max-w-4xl mx-auto items-center"),
-						Attr("hx-ext", "sse"),
-						Attr("sse-connect", "/events"),
+						HxExt("sse"),
+						SseConnect("/events"),
 						Div(
 							Class("text-center max-w-2xl mb-2"),
 							H2(Class("text-2xl font-bold mb-4"), Text("Go Memory & Garbage Collection Demo")),
@@ -259,20 +259,20 @@ func main() {
 						),
 						Div(
 							Class("w-full"),
-							Attr("sse-swap", "metrics_update"),
+							SseSwap("metrics_update"),
 							renderMetrics(0, 0, 0, 0),
 						),
 						Div(Class("flex gap-4 mt-4"),
 							Button(
 								Class("btn btn-primary"),
-								Attr("hx-post", "/allocate"),
-								Attr("hx-swap", "none"),
+								HxPost("/allocate"),
+								Swap(SwapNone),
 								Text("Allocate 5MB Memory"),
 							),
 							Button(
 								Class("btn btn-secondary"),
-								Attr("hx-post", "/gc"),
-								Attr("hx-swap", "none"),
+								HxPost("/gc"),
+								Swap(SwapNone),
 								Text("Force Garbage Collection"),
 							),
 						),
@@ -282,7 +282,7 @@ func main() {
 		)
 
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		if err := html.Render(w, page); err != nil {
+		if err := Render(r.Context(), w, page); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
@@ -123,10 +124,10 @@ func (a *App) renderRow(item *Item) *html.Node {
 		Input(
 			Type("checkbox"),
 			Class("checkbox checkbox-primary"),
-			If(item.Done, Checked()),
+			Bool("checked", item.Done),
 			HxPut(fmt.Sprintf("%s/%d/toggle", a.Prefix, item.ID)),
 			HxTarget("closest div.todo-row"),
-			HxSwap("outerHTML"),
+			Swap(SwapOuterHTML),
 		),
 		Span(
 			Class("flex-1 transition-all"),
@@ -137,7 +138,7 @@ func (a *App) renderRow(item *Item) *html.Node {
 			Class("btn btn-ghost btn-sm text-error"),
 			HxDelete(fmt.Sprintf("%s/%d", a.Prefix, item.ID)),
 			HxTarget("closest div.todo-row"),
-			HxSwap("outerHTML"),
+			Swap(SwapOuterHTML),
 			Text("Delete"),
 		),
 	)
@@ -148,12 +149,12 @@ func (a *App) renderForm(errMsg string) *html.Node {
 	return Form(
 		Id(a.formID()),
 		Class("mt-4 flex flex-col gap-2"),
-		X("data", "{ loading: false }"),
+		XData(map[string]bool{"loading": false}),
 		XOn("submit", "loading = true"),
 		HxOn("htmx:after-request", "loading = false"),
 		HxPost(a.Prefix+"/add"),
 		HxTarget("#"+a.listID()),
-		HxSwap("beforeend"),
+		Swap(SwapBeforeEnd),
 
 		Div(Class("flex gap-2"),
 			Input(
@@ -187,7 +188,7 @@ func (a *App) handleAdd(w http.ResponseWriter, r *http.Request) {
 	if text == "" {
 		// Render form with error, swap out the existing form Out Of Band
 		form := Apply(a.renderForm("Task cannot be empty"), HxSwapOob("true"))
-		_ = html.Render(w, form)
+		_ = Render(r.Context(), w, form)
 		return
 	}
 
@@ -202,11 +203,11 @@ func (a *App) handleAdd(w http.ResponseWriter, r *http.Request) {
 	a.mu.Unlock()
 
 	// Return the newly created row (appended to list)
-	_ = html.Render(w, a.renderRow(newItem))
+	_ = Render(r.Context(), w, a.renderRow(newItem))
 	// AND return a cleared form to replace the old form (Out Of Band swap)
-	_ = html.Render(w, Apply(a.renderForm(""), HxSwapOob("true")))
+	_ = Render(r.Context(), w, Apply(a.renderForm(""), HxSwapOob("true")))
 	// AND return the updated counter (Out Of Band swap)
-	_ = html.Render(w, Apply(a.renderCounter(), HxSwapOob("true")))
+	_ = Render(r.Context(), w, Apply(a.renderCounter(), HxSwapOob("true")))
 }
 
 func (a *App) handleToggle(w http.ResponseWriter, r *http.Request) {
@@ -234,7 +235,7 @@ func (a *App) handleToggle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Re-render just the updated row
-	_ = html.Render(w, a.renderRow(updatedItem))
+	_ = Render(r.Context(), w, a.renderRow(updatedItem))
 }
 
 func (a *App) handleDelete(w http.ResponseWriter, r *http.Request) {
@@ -256,7 +257,7 @@ func (a *App) handleDelete(w http.ResponseWriter, r *http.Request) {
 	a.mu.Unlock()
 
 	// Return the updated counter (Out Of Band swap)
-	_ = html.Render(w, Apply(a.renderCounter(), HxSwapOob("true")))
+	_ = Render(r.Context(), w, Apply(a.renderCounter(), HxSwapOob("true")))
 }
 
 // ---------------------------------------------------------
@@ -304,6 +305,9 @@ func main() {
 					Script(Src("https://unpkg.com/alpinejs@3.x.x/dist/cdn.min.js"), Defer()),
 					Script(Raw("document.documentElement.setAttribute('data-theme', localStorage.getItem('theme') || 'light')")),
 				),
+				// Send the head straight away so the browser can fetch the assets
+				// while the todo cards are being built.
+				Flush(),
 				Body(
 					Class("antialiased bg-base-300 min-h-screen text-base-content"),
 					Div(
@@ -317,15 +321,15 @@ func main() {
 					),
 					Div(
 						Class("p-4 flex flex-col lg:flex-row gap-8 max-w-7xl mx-auto items-start"),
-						workTodos.Render(),
-						homeTodos.Render(),
+						Lazy(func(ctx context.Context) *html.Node { return workTodos.Render() }),
+						Lazy(func(ctx context.Context) *html.Node { return homeTodos.Render() }),
 					),
 				),
 			),
 		)
 
 		w.Header().Set("Content-Type", "text/html; charset=utf-8")
-		if err := html.Render(w, page); err != nil {
+		if err := Render(r.Context(), w, page); err != nil {
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
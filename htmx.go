@@ -0,0 +1,219 @@
+package ht
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// htmx 2 attributes, as documented in the attribute reference at
+// https://htmx.org/reference/#attributes. Helpers are named after the
+// attribute: HxGet sets hx-get, which is described at
+// https://htmx.org/attributes/hx-get/, and so on.
+
+// HxGet issues a GET to the URL.
+func HxGet(v string) html.Attribute { return Attr("hx-get", v) }
+
+// HxPost issues a POST to the URL.
+func HxPost(v string) html.Attribute { return Attr("hx-post", v) }
+
+// HxPut issues a PUT to the URL.
+func HxPut(v string) html.Attribute { return Attr("hx-put", v) }
+
+// HxPatch issues a PATCH to the URL.
+func HxPatch(v string) html.Attribute { return Attr("hx-patch", v) }
+
+// HxDelete issues a DELETE to the URL.
+func HxDelete(v string) html.Attribute { return Attr("hx-delete", v) }
+
+// HxTrigger sets the events that trigger the request, e.g. "click" or
+// "input changed delay:500ms".
+func HxTrigger(v string) html.Attribute { return Attr("hx-trigger", v) }
+
+// HxTarget sets the element the response is swapped into, as a CSS selector
+// or an extended selector such as "closest tr".
+func HxTarget(v string) html.Attribute { return Attr("hx-target", v) }
+
+// HxSwap sets how the response is swapped in, e.g. "outerHTML".
+func HxSwap(v string) html.Attribute { return Attr("hx-swap", v) }
+
+// HxSwapOob marks an element in a response to be swapped in out of band,
+// by id, instead of into the target. v is "true" or a swap strategy.
+func HxSwapOob(v string) html.Attribute { return Attr("hx-swap-oob", v) }
+
+// HxSelect selects the content to swap in from the response.
+func HxSelect(v string) html.Attribute { return Attr("hx-select", v) }
+
+// HxSelectOob selects content from the response to swap in out of band, as a
+// comma-separated list of selectors.
+func HxSelectOob(v string) html.Attribute { return Attr("hx-select-oob", v) }
+
+// HxPushUrl pushes a URL into the browser location bar: "true" for the
+// request URL, "false", or a URL.
+func HxPushUrl(v string) html.Attribute { return Attr("hx-push-url", v) }
+
+// HxReplaceUrl replaces the URL in the browser location bar, taking the same
+// values as HxPushUrl.
+func HxReplaceUrl(v string) html.Attribute { return Attr("hx-replace-url", v) }
+
+// HxVals adds values to the parameters submitted with the request, as JSON.
+// HxValsJSON builds the JSON from Go values.
+func HxVals(v string) html.Attribute { return Attr("hx-vals", v) }
+
+// HxValsJSON adds values to the parameters submitted with the request,
+// encoding v, usually a map or struct, as a JSON object with encoding/json.
+// Values from users are safe to include: they arrive at the server exactly
+// as given.
+func HxValsJSON(v any) html.Attribute { return Attr("hx-vals", JS("%v", v)) }
+
+// HxValsJS adds values computed in the browser when the request is made, from
+// a JavaScript expression that returns an object, e.g.
+// HxValsJS("{width: window.innerWidth, id: %v}", id). v and args are formatted
+// with JS.
+func HxValsJS(v string, args ...any) html.Attribute { return Attr("hx-vals", "js:"+JS(v, args...)) }
+
+// HxBoost turns links and forms inside the element into AJAX requests when v
+// is "true".
+func HxBoost(v string) html.Attribute { return Attr("hx-boost", v) }
+
+// HxConfirm shows a confirm() dialog with the message before the request.
+func HxConfirm(v string) html.Attribute { return Attr("hx-confirm", v) }
+
+// HxDisable disables htmx processing for the element and its descendants.
+func HxDisable() html.Attribute { return Attr("hx-disable", "") }
+
+// HxDisabledElt adds the disabled attribute to the matching elements while a
+// request is in flight.
+func HxDisabledElt(v string) html.Attribute { return Attr("hx-disabled-elt", v) }
+
+// HxDisinherit stops the named attributes, or all with "*", from being
+// inherited by descendants.
+func HxDisinherit(attrs ...string) html.Attribute {
+	return Attr("hx-disinherit", strings.Join(attrs, " "))
+}
+
+// HxEncoding sets the request encoding, e.g. "multipart/form-data" for file
+// uploads.
+func HxEncoding(v string) html.Attribute { return Attr("hx-encoding", v) }
+
+// HxExt enables the named extensions for the element and its descendants.
+// Prefix a name with "ignore:" to disable an inherited extension.
+func HxExt(names ...string) html.Attribute { return Attr("hx-ext", strings.Join(names, ",")) }
+
+// HxHeaders adds headers to the request.
+func HxHeaders(headers map[string]string) html.Attribute {
+	return Attr("hx-headers", JS("%v", headers))
+}
+
+// HxHeadersJS adds headers computed in the browser when the request is made,
+// from a JavaScript expression that returns an object. v and args are
+// formatted with JS.
+func HxHeadersJS(v string, args ...any) html.Attribute {
+	return Attr("hx-headers", "js:"+JS(v, args...))
+}
+
+// HxHistory keeps the page out of the history cache when on is false, e.g.
+// for pages with sensitive data.
+func HxHistory(on bool) html.Attribute { return Attr("hx-history", strconv.FormatBool(on)) }
+
+// HxHistoryElt sets the element to snapshot and restore during history
+// navigation, instead of the body.
+func HxHistoryElt() html.Attribute { return Attr("hx-history-elt", "") }
+
+// HxInclude includes the values of the matching elements in the request.
+func HxInclude(v string) html.Attribute { return Attr("hx-include", v) }
+
+// HxIndicator sets the element that gets the htmx-request class during the
+// request.
+func HxIndicator(v string) html.Attribute { return Attr("hx-indicator", v) }
+
+// HxInherit enables inheritance of the named attributes, or all with "*",
+// when htmx.config.disableInheritance is set.
+func HxInherit(attrs ...string) html.Attribute { return Attr("hx-inherit", strings.Join(attrs, " ")) }
+
+// HxParams filters the parameters submitted with the request: "*", "none",
+// "not a,b" or "a,b".
+func HxParams(v string) html.Attribute { return Attr("hx-params", v) }
+
+// HxPreserve keeps the element, which must have an id, unchanged across swaps.
+func HxPreserve() html.Attribute { return Attr("hx-preserve", "") }
+
+// HxPrompt shows a prompt() with the message before the request and sends the
+// answer in the HX-Prompt header.
+func HxPrompt(v string) html.Attribute { return Attr("hx-prompt", v) }
+
+// HxRequest configures the request, e.g. `"timeout":100`. RequestConfig
+// writes the same attribute from Go values.
+func HxRequest(v string) html.Attribute { return Attr("hx-request", v) }
+
+// RequestConfig is an hx-request attribute. It can be passed to Element as
+// is, e.g. Button(HxPost("/slow"), RequestConfig{Timeout: 5 * time.Second}).
+type RequestConfig struct {
+	Timeout     time.Duration // abort the request after this long; 0 is no timeout
+	Credentials bool          // send credentials with cross-origin requests
+	NoHeaders   bool          // leave out the HX-* request headers
+}
+
+func (c RequestConfig) Attribute() html.Attribute {
+	return Attr("hx-request", JS("%v", struct {
+		Timeout     int64 `json:"timeout,omitempty"`
+		Credentials bool  `json:"credentials,omitempty"`
+		NoHeaders   bool  `json:"noHeaders,omitempty"`
+	}{c.Timeout.Milliseconds(), c.Credentials, c.NoHeaders}))
+}
+
+// HxSync synchronizes requests between elements, e.g. "closest form:abort".
+func HxSync(v string) html.Attribute { return Attr("hx-sync", v) }
+
+// HxValidate validates an input, select or textarea before it sends a request.
+func HxValidate() html.Attribute { return Attr("hx-validate", "true") }
+
+// HxOn handles the event with inline JavaScript, setting hx-on:<event>. v and
+// args are formatted with JS. For htmx's own events, use HxOnHtmx.
+func HxOn(event, v string, args ...any) html.Attribute { return Attr("hx-on:"+event, JS(v, args...)) }
+
+// HxOnHtmx handles an htmx event with inline JavaScript, using the "::"
+// shorthand: HxOnHtmx("after-request", ...) sets hx-on::after-request, the
+// same as HxOn("htmx:after-request", ...).
+func HxOnHtmx(event, v string, args ...any) html.Attribute {
+	return Attr("hx-on::"+event, JS(v, args...))
+}
+
+// URL variants of the request attributes. The URL variants take a *url.URL
+// and the Query variants append q, encoded, to path.
+
+func HxDeleteURL(u *url.URL) html.Attribute                  { return HxDelete(u.String()) }
+func HxDeleteQuery(path string, q url.Values) html.Attribute { return HxDelete(withQuery(path, q)) }
+func HxGetURL(u *url.URL) html.Attribute                     { return HxGet(u.String()) }
+func HxGetQuery(path string, q url.Values) html.Attribute    { return HxGet(withQuery(path, q)) }
+func HxPatchURL(u *url.URL) html.Attribute                   { return HxPatch(u.String()) }
+func HxPatchQuery(path string, q url.Values) html.Attribute  { return HxPatch(withQuery(path, q)) }
+func HxPostURL(u *url.URL) html.Attribute                    { return HxPost(u.String()) }
+func HxPostQuery(path string, q url.Values) html.Attribute   { return HxPost(withQuery(path, q)) }
+func HxPutURL(u *url.URL) html.Attribute                     { return HxPut(u.String()) }
+func HxPutQuery(path string, q url.Values) html.Attribute    { return HxPut(withQuery(path, q)) }
+
+// Server-sent events extension (https://htmx.org/extensions/sse/), enabled
+// with HxExt("sse").
+
+// SseConnect opens an EventSource to the URL.
+func SseConnect(v string) html.Attribute { return Attr("sse-connect", v) }
+
+// SseSwap swaps in the data of the named events as they arrive.
+func SseSwap(events ...string) html.Attribute { return Attr("sse-swap", strings.Join(events, ",")) }
+
+// SseClose closes the EventSource when the named event arrives.
+func SseClose(event string) html.Attribute { return Attr("sse-close", event) }
+
+// WebSockets extension (https://htmx.org/extensions/ws/), enabled with
+// HxExt("ws").
+
+// WsConnect opens a WebSocket to the URL.
+func WsConnect(v string) html.Attribute { return Attr("ws-connect", v) }
+
+// WsSend sends the values of the enclosing form over the nearest WebSocket
+// when the element is triggered.
+func WsSend() html.Attribute { return Attr("ws-send", "") }
@@ -0,0 +1,64 @@
+package ht
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+func TestHtmx(t *testing.T) {
+	for _, tt := range []struct {
+		attr html.Attribute
+		want string
+	}{
+		{HxOnHtmx("after-request", "done(%v)", 1), `hx-on::after-request="done(1)"`},
+		{HxOn("htmx:before-request", "start()"), `hx-on:htmx:before-request="start()"`},
+		{HxExt("sse", "ignore:head-support"), `hx-ext="sse,ignore:head-support"`},
+		{HxDisinherit("hx-target", "hx-select"), `hx-disinherit="hx-target hx-select"`},
+		{HxHistory(false), `hx-history="false"`},
+		{SseSwap("message", "update"), `sse-swap="message,update"`},
+	} {
+		if got := tt.attr.Key + `="` + tt.attr.Val + `"`; got != tt.want {
+			t.Errorf("got %s, want %s", got, tt.want)
+		}
+	}
+}
+
+func TestHxJSON(t *testing.T) {
+	vals := map[string]any{"q": `"><script>alert('x')</script>&amp;`, "n": 2.5, "tags": []any{"a", "b"}}
+	headers := map[string]string{"X-Token": `a"b'c`}
+	n := Button(HxValsJSON(vals), HxHeaders(headers), RequestConfig{Timeout: 1500 * time.Millisecond, NoHeaders: true})
+
+	var b strings.Builder
+	if err := Render(context.Background(), &b, n); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := html.Parse(strings.NewReader(stripSources(b.String())))
+	if err != nil {
+		t.Fatal(err)
+	}
+	button := doc.FirstChild.LastChild.FirstChild
+	if button == nil || button.Data != "button" || len(button.Attr) != 3 {
+		t.Fatalf("unexpected parse of %s", stripSources(b.String()))
+	}
+
+	var gotVals map[string]any
+	var gotHeaders map[string]string
+	if err := json.Unmarshal([]byte(button.Attr[0].Val), &gotVals); err != nil || !reflect.DeepEqual(gotVals, vals) {
+		t.Errorf("hx-vals: got %v (%v), want %v", gotVals, err, vals)
+	}
+	if err := json.Unmarshal([]byte(button.Attr[1].Val), &gotHeaders); err != nil || !reflect.DeepEqual(gotHeaders, headers) {
+		t.Errorf("hx-headers: got %v (%v), want %v", gotHeaders, err, headers)
+	}
+	if got, want := button.Attr[2].Val, `{"timeout":1500,"noHeaders":true}`; got != want {
+		t.Errorf("hx-request: got %s, want %s", got, want)
+	}
+	if got, want := HxValsJS("{w: window.innerWidth, id: %v}", "a<b").Val, `js:{w: window.innerWidth, id: "a\u003cb"}`; got != want {
+		t.Errorf("HxValsJS: got %s, want %s", got, want)
+	}
+}
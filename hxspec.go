@@ -0,0 +1,171 @@
+package ht
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+// TriggerSpec is one trigger of an hx-trigger attribute, built with Trigger
+// or Every and refined with its modifier methods. Each method returns a new
+// TriggerSpec, so a spec can be shared and extended. A TriggerSpec can be
+// passed to Element as is; use Triggers to combine several.
+//
+// See https://htmx.org/attributes/hx-trigger/.
+type TriggerSpec struct {
+	event  string
+	filter string
+	mods   []string
+}
+
+// Trigger starts a trigger on the named event, such as "click", "load",
+// "revealed", "intersect" or "sse:message".
+func Trigger(event string) TriggerSpec { return TriggerSpec{event: event} }
+
+// Every starts a polling trigger that fires every d.
+func Every(d time.Duration) TriggerSpec { return TriggerSpec{event: "every " + hxDuration(d)} }
+
+// Filter only fires the trigger when the JavaScript expression is true,
+// e.g. "ctrlKey" or "this.value.length > 2".
+func (t TriggerSpec) Filter(js string) TriggerSpec { t.filter = js; return t }
+
+// Once fires the trigger only once.
+func (t TriggerSpec) Once() TriggerSpec { return t.with("once") }
+
+// Changed fires the trigger only if the element's value has changed.
+func (t TriggerSpec) Changed() TriggerSpec { return t.with("changed") }
+
+// Delay waits d before firing, restarting the wait on every new event.
+func (t TriggerSpec) Delay(d time.Duration) TriggerSpec { return t.with("delay:" + hxDuration(d)) }
+
+// Throttle fires at once, then ignores events for d.
+func (t TriggerSpec) Throttle(d time.Duration) TriggerSpec {
+	return t.with("throttle:" + hxDuration(d))
+}
+
+// From listens for the event on other elements, selected with an extended
+// CSS selector such as "document" or "closest form".
+func (t TriggerSpec) From(selector string) TriggerSpec { return t.with("from:" + selector) }
+
+// Target only fires for events whose target matches the selector.
+func (t TriggerSpec) Target(selector string) TriggerSpec { return t.with("target:" + selector) }
+
+// Consume stops the event from triggering requests on parent elements.
+func (t TriggerSpec) Consume() TriggerSpec { return t.with("consume") }
+
+// Queue sets which events are queued while a request is in flight: "first",
+// "last", "all" or "none".
+func (t TriggerSpec) Queue(v string) TriggerSpec { return t.with("queue:" + v) }
+
+// Root sets the root element for an "intersect" trigger.
+func (t TriggerSpec) Root(selector string) TriggerSpec { return t.with("root:" + selector) }
+
+// Threshold sets how much of the element must be visible for an "intersect"
+// trigger, from 0 to 1.
+func (t TriggerSpec) Threshold(v float64) TriggerSpec { return t.with("threshold:" + formatFloat(v)) }
+
+// String returns the trigger in hx-trigger syntax.
+func (t TriggerSpec) String() string {
+	s := t.event
+	if t.filter != "" {
+		s += "[" + t.filter + "]"
+	}
+	if len(t.mods) > 0 {
+		s += " " + strings.Join(t.mods, " ")
+	}
+	return s
+}
+
+func (t TriggerSpec) Attribute() html.Attribute { return HxTrigger(t.String()) }
+
+func (t TriggerSpec) with(mod string) TriggerSpec {
+	t.mods = append(slices.Clip(t.mods), mod)
+	return t
+}
+
+// Triggers returns an hx-trigger attribute with several triggers, e.g.
+// Triggers(Trigger("input").Changed().Delay(500*time.Millisecond), Trigger("search")).
+func Triggers(triggers ...TriggerSpec) html.Attribute {
+	s := make([]string, len(triggers))
+	for i, t := range triggers {
+		s[i] = t.String()
+	}
+	return HxTrigger(strings.Join(s, ", "))
+}
+
+// SwapStyle is how htmx swaps a response into its target.
+type SwapStyle string
+
+const (
+	SwapInnerHTML   SwapStyle = "innerHTML"
+	SwapOuterHTML   SwapStyle = "outerHTML"
+	SwapTextContent SwapStyle = "textContent"
+	SwapBeforeBegin SwapStyle = "beforebegin"
+	SwapAfterBegin  SwapStyle = "afterbegin"
+	SwapBeforeEnd   SwapStyle = "beforeend"
+	SwapAfterEnd    SwapStyle = "afterend"
+	SwapDelete      SwapStyle = "delete"
+	SwapNone        SwapStyle = "none"
+)
+
+// SwapSpec is an hx-swap attribute, built with Swap and refined with its
+// modifier methods. Like TriggerSpec, each method returns a new SwapSpec and
+// a SwapSpec can be passed to Element as is.
+//
+// See https://htmx.org/attributes/hx-swap/.
+type SwapSpec struct {
+	style SwapStyle
+	mods  []string
+}
+
+// Swap starts an hx-swap attribute with the given style.
+func Swap(style SwapStyle) SwapSpec { return SwapSpec{style: style} }
+
+// Transition uses the View Transitions API for the swap.
+func (s SwapSpec) Transition() SwapSpec { return s.with("transition:true") }
+
+// Delay waits d between receiving the response and swapping it in.
+func (s SwapSpec) Delay(d time.Duration) SwapSpec { return s.with("swap:" + hxDuration(d)) }
+
+// Settle waits d between swapping the content in and settling its attributes.
+func (s SwapSpec) Settle(d time.Duration) SwapSpec { return s.with("settle:" + hxDuration(d)) }
+
+// IgnoreTitle keeps the page title when the response has a <title>.
+func (s SwapSpec) IgnoreTitle() SwapSpec { return s.with("ignoreTitle:true") }
+
+// Scroll scrolls the target, or the element a selector prefix picks, to the
+// "top" or "bottom", e.g. Scroll("top") or Scroll("#list:bottom").
+func (s SwapSpec) Scroll(v string) SwapSpec { return s.with("scroll:" + v) }
+
+// Show scrolls the page so the target's, or a selected element's, "top" or
+// "bottom" is shown, e.g. Show("window:top"). Show("none") disables the
+// default scrolling of boosted links.
+func (s SwapSpec) Show(v string) SwapSpec { return s.with("show:" + v) }
+
+// FocusScroll sets whether the page scrolls to a focused input after the swap.
+func (s SwapSpec) FocusScroll(on bool) SwapSpec {
+	return s.with("focus-scroll:" + strconv.FormatBool(on))
+}
+
+// String returns the swap in hx-swap syntax.
+func (s SwapSpec) String() string {
+	return strings.Join(append([]string{string(s.style)}, s.mods...), " ")
+}
+
+func (s SwapSpec) Attribute() html.Attribute { return HxSwap(s.String()) }
+
+func (s SwapSpec) with(mod string) SwapSpec {
+	s.mods = append(slices.Clip(s.mods), mod)
+	return s
+}
+
+// hxDuration formats d as htmx expects, in whole seconds or milliseconds.
+func hxDuration(d time.Duration) string {
+	if d%time.Second == 0 {
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+	return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
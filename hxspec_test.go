@@ -0,0 +1,45 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/net/html"
+)
+
+func TestHxSpecs(t *testing.T) {
+	search := Trigger("input").Changed().Delay(500 * time.Millisecond)
+	for _, tt := range []struct {
+		attr html.Attribute
+		want string
+	}{
+		{search.Attribute(), "input changed delay:500ms"},
+		{Triggers(search, Trigger("search")), "input changed delay:500ms, search"},
+		{Trigger("click").Filter("ctrlKey").From("#x").Throttle(2 * time.Second).Attribute(), "click[ctrlKey] from:#x throttle:2s"},
+		{Every(time.Minute).Attribute(), "every 60s"},
+		{Trigger("intersect").Once().Threshold(0.5).Attribute(), "intersect once threshold:0.5"},
+		{Swap(SwapOuterHTML).Attribute(), "outerHTML"},
+		{Swap(SwapBeforeEnd).Transition().Settle(100 * time.Millisecond).Scroll("bottom").Attribute(), "beforeend transition:true settle:100ms scroll:bottom"},
+	} {
+		if tt.attr.Val != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.attr.Key, tt.attr.Val, tt.want)
+		}
+	}
+
+	// Extending a shared spec must not change it or its other extensions.
+	base := Trigger("keyup").Changed()
+	a, b := base.Delay(time.Second), base.Once()
+	if base.String() != "keyup changed" || a.String() != "keyup changed delay:1s" || b.String() != "keyup changed once" {
+		t.Errorf("specs share state: %q, %q, %q", base, a, b)
+	}
+
+	var buf strings.Builder
+	if err := Render(context.Background(), &buf, Input(search, Swap(SwapInnerHTML))); err != nil {
+		t.Fatal(err)
+	}
+	if want := `<input hx-trigger="input changed delay:500ms" hx-swap="innerHTML"/>`; stripSources(buf.String()) != want {
+		t.Errorf("got %s, want %s", stripSources(buf.String()), want)
+	}
+}
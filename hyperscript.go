@@ -0,0 +1,11 @@
+package ht
+
+import "golang.org/x/net/html"
+
+// Hs sets a _hyperscript script (https://hyperscript.org). attr is the
+// attribute hyperscript reads it from: "_", "script" or "data-script".
+// script and args are formatted with JS, so Go values can be passed in as
+// args:
+//
+//	Button(Hs("_", "on click put %v into #greeting", name), Text("Greet"))
+func Hs(attr, script string, args ...any) html.Attribute { return Attr(attr, JS(script, args...)) }
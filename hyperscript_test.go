@@ -0,0 +1,17 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestHyperscript(t *testing.T) {
+	var b strings.Builder
+	if err := Render(context.Background(), &b, Div(Hs("_", "on click put %v into me", "<b>"))); err != nil {
+		t.Fatal(err)
+	}
+	if want := `<div _="on click put &#34;\u003cb\u003e&#34; into me"></div>`; stripSources(b.String()) != want {
+		t.Errorf("got %s, want %s", stripSources(b.String()), want)
+	}
+}
@@ -0,0 +1,162 @@
+package main
+
+// attribute describes an HTML content attribute, as listed in the attribute
+// index of the WHATWG HTML standard
+// (https://html.spec.whatwg.org/multipage/indices.html#attributes-3).
+type attribute struct {
+	Name     string
+	Elements []string // the elements it applies to, or none for global attributes
+	Desc     string   // what the attribute does
+	Bool     bool     // a boolean attribute, set by its presence alone
+	Func     string   // the helper name, if attrName would not do
+}
+
+// Data builds data-* attributes, so the data attribute of <object> is
+// DataAttr.
+//
+// attributes excludes event handlers, which On sets, and the attributes with
+// hand-written helpers in attrs.go: class, content and datetime, as well as
+// aria-* and data-*.
+var attributes = []attribute{
+	{Name: "abbr", Elements: []string{"th"}, Desc: "an alternative label for the header cell"},
+	{Name: "accept", Elements: []string{"input"}, Desc: "the file types expected in a file upload"},
+	{Name: "accept-charset", Elements: []string{"form"}, Desc: "the character encoding to use for form submission"},
+	{Name: "accesskey", Desc: "a keyboard shortcut to activate or focus the element"},
+	{Name: "action", Elements: []string{"form"}, Desc: "the URL to use for form submission"},
+	{Name: "allow", Elements: []string{"iframe"}, Desc: "the permissions policy for the frame's contents"},
+	{Name: "allowfullscreen", Elements: []string{"iframe"}, Desc: "allows the frame's contents to go fullscreen", Bool: true},
+	{Name: "alpha", Elements: []string{"input"}, Desc: "allows a color's alpha component to be set", Bool: true},
+	{Name: "alt", Elements: []string{"area", "img", "input"}, Desc: "the replacement text for when images are not available"},
+	{Name: "as", Elements: []string{"link"}, Desc: "the destination of a preload request"},
+	{Name: "async", Elements: []string{"script"}, Desc: "runs the script when it is available, without blocking the page", Bool: true},
+	{Name: "autocapitalize", Desc: "the recommended autocapitalization behavior"},
+	{Name: "autocomplete", Elements: []string{"form", "input", "select", "textarea"}, Desc: "a hint for the form autofill feature"},
+	{Name: "autocorrect", Desc: "whether automatic spelling correction is enabled"},
+	{Name: "autofocus", Desc: "focuses the element when the page is loaded", Bool: true},
+	{Name: "autoplay", Elements: []string{"audio", "video"}, Desc: "starts playing the media automatically", Bool: true},
+	{Name: "blocking", Elements: []string{"link", "script", "style"}, Desc: "whether the element is potentially render-blocking"},
+	{Name: "charset", Elements: []string{"meta"}, Desc: "the character encoding declaration"},
+	{Name: "checked", Elements: []string{"input"}, Desc: "whether the control is checked", Bool: true},
+	{Name: "cite", Elements: []string{"blockquote", "del", "ins", "q"}, Desc: "a link to the source of the quotation or more information about the edit"},
+	{Name: "closedby", Elements: []string{"dialog"}, Desc: "which user actions close the dialog"},
+	{Name: "color", Elements: []string{"link"}, Desc: "the color to use when customizing a site's icon"},
+	{Name: "colorspace", Elements: []string{"input"}, Desc: "the color space of the serialized color"},
+	{Name: "cols", Elements: []string{"textarea"}, Desc: "the maximum number of characters per line"},
+	{Name: "colspan", Elements: []string{"td", "th"}, Desc: "the number of columns the cell spans"},
+	{Name: "command", Elements: []string{"button"}, Desc: "the command to invoke on the element named by commandfor"},
+	{Name: "commandfor", Elements: []string{"button"}, Desc: "the id of the element the button controls"},
+	{Name: "contenteditable", Desc: "whether the element is editable"},
+	{Name: "controls", Elements: []string{"audio", "video"}, Desc: "shows the browser's media controls", Bool: true},
+	{Name: "coords", Elements: []string{"area"}, Desc: "the coordinates of the shape in an image map"},
+	{Name: "crossorigin", Elements: []string{"audio", "img", "link", "script", "video"}, Desc: "how the element handles cross-origin requests"},
+	{Name: "data", Elements: []string{"object"}, Desc: "the address of the resource", Func: "DataAttr"},
+	{Name: "decoding", Elements: []string{"img"}, Desc: "a decoding hint for presenting the image"},
+	{Name: "default", Elements: []string{"track"}, Desc: "enables the track if no other text track is more suitable", Bool: true},
+	{Name: "defer", Elements: []string{"script"}, Desc: "defers running the script until the document is parsed", Bool: true},
+	{Name: "dir", Desc: "the text directionality of the element"},
+	{Name: "dirname", Elements: []string{"input", "textarea"}, Desc: "the name of the form field that submits the element's directionality"},
+	{Name: "disabled", Elements: []string{"button", "input", "optgroup", "option", "select", "textarea", "fieldset", "link"}, Desc: "whether the control is disabled", Bool: true},
+	{Name: "download", Elements: []string{"a", "area"}, Desc: "downloads the resource instead of navigating to it, with an optional filename"},
+	{Name: "draggable", Desc: "whether the element is draggable"},
+	{Name: "enctype", Elements: []string{"form"}, Desc: "the encoding type to use for form submission"},
+	{Name: "enterkeyhint", Desc: "a hint for the action label of the virtual keyboard's enter key"},
+	{Name: "exportparts", Desc: "the shadow parts to expose outside the shadow tree"},
+	{Name: "fetchpriority", Elements: []string{"img", "link", "script"}, Desc: "the priority of fetches the element starts"},
+	{Name: "for", Elements: []string{"label", "output"}, Desc: "the id of the control the label is for, or of the controls an output is calculated from"},
+	{Name: "form", Elements: []string{"button", "fieldset", "input", "object", "output", "select", "textarea"}, Desc: "the id of the form the element belongs to"},
+	{Name: "formaction", Elements: []string{"button", "input"}, Desc: "the URL to use for form submission, overriding the form's action"},
+	{Name: "formenctype", Elements: []string{"button", "input"}, Desc: "the encoding type to use for form submission, overriding the form's enctype"},
+	{Name: "formmethod", Elements: []string{"button", "input"}, Desc: "the HTTP method to use for form submission, overriding the form's method"},
+	{Name: "formnovalidate", Elements: []string{"button", "input"}, Desc: "skips form validation on submission", Bool: true},
+	{Name: "formtarget", Elements: []string{"button", "input"}, Desc: "the navigable for form submission, overriding the form's target"},
+	{Name: "headers", Elements: []string{"td", "th"}, Desc: "the ids of the header cells for the cell"},
+	{Name: "height", Elements: []string{"canvas", "embed", "iframe", "img", "input", "object", "source", "video"}, Desc: "the vertical dimension"},
+	{Name: "hidden", Desc: "hides the element", Bool: true},
+	{Name: "high", Elements: []string{"meter"}, Desc: "the low limit of the high range"},
+	{Name: "href", Elements: []string{"a", "area", "base", "link"}, Desc: "the address of the hyperlink"},
+	{Name: "hreflang", Elements: []string{"a", "link"}, Desc: "the language of the linked resource"},
+	{Name: "http-equiv", Elements: []string{"meta"}, Desc: "a pragma directive"},
+	{Name: "id", Desc: "the element's unique identifier"},
+	{Name: "imagesizes", Elements: []string{"link"}, Desc: "the image sizes for different page layouts, for preloading"},
+	{Name: "imagesrcset", Elements: []string{"link"}, Desc: "the images to use in different situations, for preloading"},
+	{Name: "inert", Desc: "makes the element and its descendants non-interactive", Bool: true},
+	{Name: "inputmode", Desc: "a hint for the kind of virtual keyboard to show"},
+	{Name: "integrity", Elements: []string{"link", "script"}, Desc: "the subresource integrity metadata"},
+	{Name: "is", Desc: "the name of the customized built-in element to create"},
+	{Name: "ismap", Elements: []string{"img"}, Desc: "marks the image as a server-side image map", Bool: true},
+	{Name: "itemid", Desc: "the global identifier of a microdata item"},
+	{Name: "itemprop", Desc: "the property names of a microdata item"},
+	{Name: "itemref", Desc: "the ids of elements with additional properties of a microdata item"},
+	{Name: "itemscope", Desc: "introduces a microdata item", Bool: true},
+	{Name: "itemtype", Desc: "the item types of a microdata item"},
+	{Name: "kind", Elements: []string{"track"}, Desc: "the type of text track"},
+	{Name: "label", Elements: []string{"optgroup", "option", "track"}, Desc: "the user-visible label"},
+	{Name: "lang", Desc: "the language of the element"},
+	{Name: "list", Elements: []string{"input"}, Desc: "the id of a datalist with autocomplete options"},
+	{Name: "loading", Elements: []string{"iframe", "img"}, Desc: "when to load the resource"},
+	{Name: "loop", Elements: []string{"audio", "video"}, Desc: "loops the media", Bool: true},
+	{Name: "low", Elements: []string{"meter"}, Desc: "the high limit of the low range"},
+	{Name: "max", Elements: []string{"input", "meter", "progress"}, Desc: "the maximum value"},
+	{Name: "maxlength", Elements: []string{"input", "textarea"}, Desc: "the maximum length of the value"},
+	{Name: "media", Elements: []string{"link", "meta", "source", "style"}, Desc: "the media the element applies to"},
+	{Name: "method", Elements: []string{"form"}, Desc: "the HTTP method to use for form submission"},
+	{Name: "min", Elements: []string{"input", "meter"}, Desc: "the minimum value"},
+	{Name: "minlength", Elements: []string{"input", "textarea"}, Desc: "the minimum length of the value"},
+	{Name: "multiple", Elements: []string{"input", "select"}, Desc: "allows multiple values", Bool: true},
+	{Name: "muted", Elements: []string{"audio", "video"}, Desc: "mutes the media by default", Bool: true},
+	{Name: "name", Elements: []string{"button", "details", "fieldset", "form", "iframe", "input", "map", "meta", "object", "output", "select", "slot", "textarea"}, Desc: "the name of the element, e.g. of the form control"},
+	{Name: "nomodule", Elements: []string{"script"}, Desc: "skips the script in browsers that support modules", Bool: true},
+	{Name: "nonce", Desc: "the cryptographic nonce for Content Security Policy checks"},
+	{Name: "novalidate", Elements: []string{"form"}, Desc: "skips form validation on submission", Bool: true},
+	{Name: "open", Elements: []string{"details", "dialog"}, Desc: "whether the details or dialog are showing", Bool: true},
+	{Name: "optimum", Elements: []string{"meter"}, Desc: "the optimum value"},
+	{Name: "part", Desc: "the shadow part names of the element"},
+	{Name: "pattern", Elements: []string{"input"}, Desc: "the pattern the value must match"},
+	{Name: "ping", Elements: []string{"a", "area"}, Desc: "the URLs to ping when the hyperlink is followed"},
+	{Name: "placeholder", Elements: []string{"input", "textarea"}, Desc: "a hint shown while the control is empty"},
+	{Name: "playsinline", Elements: []string{"video"}, Desc: "plays the video inline instead of fullscreen", Bool: true},
+	{Name: "popover", Desc: "makes the element a popover"},
+	{Name: "popovertarget", Elements: []string{"button", "input"}, Desc: "the id of the popover the button controls"},
+	{Name: "popovertargetaction", Elements: []string{"button", "input"}, Desc: "whether the button shows, hides or toggles its popover"},
+	{Name: "poster", Elements: []string{"video"}, Desc: "the image to show before the video plays"},
+	{Name: "preload", Elements: []string{"audio", "video"}, Desc: "how much of the media to buffer in advance"},
+	{Name: "readonly", Elements: []string{"input", "textarea"}, Desc: "prevents the value from being edited", Bool: true},
+	{Name: "referrerpolicy", Elements: []string{"a", "area", "iframe", "img", "link", "script"}, Desc: "the referrer policy for fetches the element starts"},
+	{Name: "rel", Elements: []string{"a", "area", "form", "link"}, Desc: "the relationship between the document and the linked resource"},
+	{Name: "required", Elements: []string{"input", "select", "textarea"}, Desc: "whether the control is required for form submission", Bool: true},
+	{Name: "reversed", Elements: []string{"ol"}, Desc: "numbers the list backwards", Bool: true},
+	{Name: "role", Desc: "the ARIA role of the element, from WAI-ARIA"},
+	{Name: "rows", Elements: []string{"textarea"}, Desc: "the number of lines to show"},
+	{Name: "rowspan", Elements: []string{"td", "th"}, Desc: "the number of rows the cell spans"},
+	{Name: "sandbox", Elements: []string{"iframe"}, Desc: "the security rules for the frame's contents"},
+	{Name: "scope", Elements: []string{"th"}, Desc: "the cells the header cell applies to"},
+	{Name: "selected", Elements: []string{"option"}, Desc: "whether the option is selected by default", Bool: true},
+	{Name: "shadowrootclonable", Elements: []string{"template"}, Desc: "makes the declarative shadow root clonable", Bool: true},
+	{Name: "shadowrootcustomelementregistry", Elements: []string{"template"}, Desc: "gives the declarative shadow root its own custom element registry", Bool: true},
+	{Name: "shadowrootdelegatesfocus", Elements: []string{"template"}, Desc: "makes the declarative shadow root delegate focus", Bool: true},
+	{Name: "shadowrootmode", Elements: []string{"template"}, Desc: "creates a declarative shadow root in the given mode"},
+	{Name: "shadowrootserializable", Elements: []string{"template"}, Desc: "makes the declarative shadow root serializable", Bool: true},
+	{Name: "shape", Elements: []string{"area"}, Desc: "the kind of shape in an image map"},
+	{Name: "size", Elements: []string{"input", "select"}, Desc: "the size of the control"},
+	{Name: "sizes", Elements: []string{"img", "link", "source"}, Desc: "the image sizes for different page layouts"},
+	{Name: "slot", Desc: "the name of the shadow tree slot to place the element in"},
+	{Name: "span", Elements: []string{"col", "colgroup"}, Desc: "the number of columns spanned"},
+	{Name: "spellcheck", Desc: "whether the element is spellchecked"},
+	{Name: "src", Elements: []string{"audio", "embed", "iframe", "img", "input", "script", "source", "track", "video"}, Desc: "the address of the resource"},
+	{Name: "srcdoc", Elements: []string{"iframe"}, Desc: "a document to render in the frame"},
+	{Name: "srclang", Elements: []string{"track"}, Desc: "the language of the text track"},
+	{Name: "srcset", Elements: []string{"img", "source"}, Desc: "the images to use in different situations"},
+	{Name: "start", Elements: []string{"ol"}, Desc: "the starting value of the list"},
+	{Name: "step", Elements: []string{"input"}, Desc: "the granularity of the value"},
+	{Name: "style", Desc: "the element's inline CSS declarations"},
+	{Name: "tabindex", Desc: "whether the element is focusable and where it comes in the tab order"},
+	{Name: "target", Elements: []string{"a", "area", "base", "form"}, Desc: "the navigable for hyperlink navigation or form submission"},
+	{Name: "title", Desc: "advisory information for the element, shown as a tooltip"},
+	{Name: "translate", Desc: "whether the element is translated when the page is localized"},
+	{Name: "type", Elements: []string{"a", "button", "embed", "input", "link", "object", "ol", "script", "source", "style"}, Desc: "the type of the element, control or linked resource"},
+	{Name: "usemap", Elements: []string{"img"}, Desc: "the name of the image map to use"},
+	{Name: "value", Elements: []string{"button", "data", "input", "li", "meter", "option", "progress"}, Desc: "the value of the element"},
+	{Name: "width", Elements: []string{"canvas", "embed", "iframe", "img", "input", "object", "source", "video"}, Desc: "the horizontal dimension"},
+	{Name: "wrap", Elements: []string{"textarea"}, Desc: "how the value is wrapped for form submission"},
+	{Name: "writingsuggestions", Desc: "whether the browser offers writing suggestions"},
+}
@@ -0,0 +1,142 @@
+package main
+
+// element describes an HTML element, as listed in the element index of the
+// WHATWG HTML standard (https://html.spec.whatwg.org/multipage/indices.html).
+type element struct {
+	Tag        string
+	Desc       string   // what the element represents
+	Categories []string // content categories, plus VoidElement
+	Name       string   // the constructor name, if not the title-cased tag
+}
+
+// Content categories. Categories that only apply in some circumstances, such
+// as phrasing content for an <a> inside phrasing content, are included.
+const (
+	metadata    = "MetadataContent"
+	flow        = "FlowContent"
+	sectioning  = "SectioningContent"
+	heading     = "HeadingContent"
+	phrasing    = "PhrasingContent"
+	embedded    = "EmbeddedContent"
+	interactive = "InteractiveContent"
+	form        = "FormAssociated"
+	script      = "ScriptSupporting"
+	void        = "VoidElement"
+)
+
+// elements excludes <svg> and <math>, whose content is in foreign namespaces,
+// and obsolete elements.
+var elements = []element{
+	{Tag: "a", Desc: "a hyperlink", Categories: []string{flow, phrasing, interactive}},
+	{Tag: "abbr", Desc: "an abbreviation", Categories: []string{flow, phrasing}},
+	{Tag: "address", Desc: "contact information for a page or article", Categories: []string{flow}},
+	{Tag: "area", Desc: "a hyperlink or dead area on an image map", Categories: []string{flow, phrasing, void}},
+	{Tag: "article", Desc: "a self-contained, syndicatable or reusable composition", Categories: []string{flow, sectioning}},
+	{Tag: "aside", Desc: "a sidebar for tangentially related content", Categories: []string{flow, sectioning}},
+	{Tag: "audio", Desc: "an audio player", Categories: []string{flow, phrasing, embedded, interactive}},
+	{Tag: "b", Desc: "keywords", Categories: []string{flow, phrasing}},
+	{Tag: "base", Desc: "the base URL and default target for hyperlinks and forms", Categories: []string{metadata, void}},
+	{Tag: "bdi", Desc: "text directionality isolation", Categories: []string{flow, phrasing}},
+	{Tag: "bdo", Desc: "text directionality formatting", Categories: []string{flow, phrasing}},
+	{Tag: "blockquote", Desc: "a section quoted from another source", Categories: []string{flow}},
+	{Tag: "body", Desc: "the document body"},
+	{Tag: "br", Desc: "a line break, e.g. in a poem or postal address", Categories: []string{flow, phrasing, void}},
+	{Tag: "button", Desc: "a button control", Categories: []string{flow, phrasing, interactive, form}},
+	{Tag: "canvas", Desc: "a scriptable bitmap canvas", Categories: []string{flow, phrasing, embedded}},
+	{Tag: "caption", Desc: "a table caption"},
+	{Tag: "cite", Desc: "the title of a work", Categories: []string{flow, phrasing}},
+	{Tag: "code", Desc: "computer code", Categories: []string{flow, phrasing}},
+	{Tag: "col", Desc: "a table column", Categories: []string{void}},
+	{Tag: "colgroup", Desc: "a group of columns in a table"},
+	{Tag: "data", Desc: "a machine-readable equivalent of its content", Categories: []string{flow, phrasing}, Name: "DataElem"},
+	{Tag: "datalist", Desc: "a container for the options of a combo box control", Categories: []string{flow, phrasing}},
+	{Tag: "dd", Desc: "the content for the corresponding dt elements"},
+	{Tag: "del", Desc: "a removal from the document", Categories: []string{flow, phrasing}},
+	{Tag: "details", Desc: "a disclosure control for hiding details", Categories: []string{flow, interactive}},
+	{Tag: "dfn", Desc: "the defining instance of a term", Categories: []string{flow, phrasing}},
+	{Tag: "dialog", Desc: "a dialog box or window", Categories: []string{flow}},
+	{Tag: "div", Desc: "a generic flow container", Categories: []string{flow}},
+	{Tag: "dl", Desc: "an association list of name-value groups", Categories: []string{flow}},
+	{Tag: "dt", Desc: "the legend for the corresponding dd elements"},
+	{Tag: "em", Desc: "stress emphasis", Categories: []string{flow, phrasing}},
+	{Tag: "embed", Desc: "a plugin", Categories: []string{flow, phrasing, embedded, interactive, void}},
+	{Tag: "fieldset", Desc: "a group of form controls", Categories: []string{flow, form}},
+	{Tag: "figcaption", Desc: "the caption of a figure"},
+	{Tag: "figure", Desc: "a figure with an optional caption", Categories: []string{flow}},
+	{Tag: "footer", Desc: "the footer of a page or section", Categories: []string{flow}},
+	{Tag: "form", Desc: "a user-submittable form", Categories: []string{flow}},
+	{Tag: "h1", Desc: "a top-level heading", Categories: []string{flow, heading}},
+	{Tag: "h2", Desc: "a second-level heading", Categories: []string{flow, heading}},
+	{Tag: "h3", Desc: "a third-level heading", Categories: []string{flow, heading}},
+	{Tag: "h4", Desc: "a fourth-level heading", Categories: []string{flow, heading}},
+	{Tag: "h5", Desc: "a fifth-level heading", Categories: []string{flow, heading}},
+	{Tag: "h6", Desc: "a sixth-level heading", Categories: []string{flow, heading}},
+	{Tag: "head", Desc: "the container for document metadata"},
+	{Tag: "header", Desc: "introductory or navigational aids for a page or section", Categories: []string{flow}},
+	{Tag: "hgroup", Desc: "a heading together with related content", Categories: []string{flow, heading}},
+	{Tag: "hr", Desc: "a thematic break", Categories: []string{flow, void}},
+	{Tag: "html", Desc: "the root element"},
+	{Tag: "i", Desc: "an alternate voice", Categories: []string{flow, phrasing}},
+	{Tag: "iframe", Desc: "a child navigable", Categories: []string{flow, phrasing, embedded, interactive}},
+	{Tag: "img", Desc: "an image", Categories: []string{flow, phrasing, embedded, interactive, form, void}},
+	{Tag: "input", Desc: "a form control", Categories: []string{flow, phrasing, interactive, form, void}},
+	{Tag: "ins", Desc: "an addition to the document", Categories: []string{flow, phrasing}},
+	{Tag: "kbd", Desc: "user input", Categories: []string{flow, phrasing}},
+	{Tag: "label", Desc: "the caption of a form control", Categories: []string{flow, phrasing, interactive}},
+	{Tag: "legend", Desc: "the caption of a fieldset"},
+	{Tag: "li", Desc: "a list item"},
+	{Tag: "link", Desc: "link metadata", Categories: []string{metadata, flow, phrasing, void}},
+	{Tag: "main", Desc: "the container for the dominant contents of the document", Categories: []string{flow}},
+	{Tag: "map", Desc: "an image map", Categories: []string{flow, phrasing}},
+	{Tag: "mark", Desc: "highlighted text", Categories: []string{flow, phrasing}},
+	{Tag: "menu", Desc: "a menu of commands", Categories: []string{flow}},
+	{Tag: "meta", Desc: "text metadata", Categories: []string{metadata, flow, phrasing, void}},
+	{Tag: "meter", Desc: "a gauge", Categories: []string{flow, phrasing, form}},
+	{Tag: "nav", Desc: "a section with navigational links", Categories: []string{flow, sectioning}},
+	{Tag: "noscript", Desc: "fallback content for when scripting is disabled", Categories: []string{metadata, flow, phrasing}},
+	{Tag: "object", Desc: "an image, child navigable or plugin", Categories: []string{flow, phrasing, embedded, interactive, form}},
+	{Tag: "ol", Desc: "an ordered list", Categories: []string{flow}},
+	{Tag: "optgroup", Desc: "a group of options in a list box"},
+	{Tag: "option", Desc: "an option in a list box or combo box control"},
+	{Tag: "output", Desc: "a calculated output value", Categories: []string{flow, phrasing, form}},
+	{Tag: "p", Desc: "a paragraph", Categories: []string{flow}},
+	{Tag: "picture", Desc: "an image with alternative sources", Categories: []string{flow, phrasing, embedded}},
+	{Tag: "pre", Desc: "a block of preformatted text", Categories: []string{flow}},
+	{Tag: "progress", Desc: "a progress bar", Categories: []string{flow, phrasing, form}},
+	{Tag: "q", Desc: "a quotation", Categories: []string{flow, phrasing}},
+	{Tag: "rp", Desc: "a parenthesis for ruby annotation text"},
+	{Tag: "rt", Desc: "ruby annotation text"},
+	{Tag: "ruby", Desc: "ruby annotations", Categories: []string{flow, phrasing}},
+	{Tag: "s", Desc: "inaccurate text", Categories: []string{flow, phrasing}},
+	{Tag: "samp", Desc: "computer output", Categories: []string{flow, phrasing}},
+	{Tag: "script", Desc: "an embedded script", Categories: []string{metadata, flow, phrasing, script}},
+	{Tag: "search", Desc: "a container for search controls", Categories: []string{flow}},
+	{Tag: "section", Desc: "a generic document or application section", Categories: []string{flow, sectioning}},
+	{Tag: "select", Desc: "a list box control", Categories: []string{flow, phrasing, interactive, form}},
+	{Tag: "slot", Desc: "a shadow tree slot", Categories: []string{flow, phrasing}},
+	{Tag: "small", Desc: "a side comment", Categories: []string{flow, phrasing}},
+	{Tag: "source", Desc: "an image source for img or a media source for video or audio", Categories: []string{void}},
+	{Tag: "span", Desc: "a generic phrasing container", Categories: []string{flow, phrasing}},
+	{Tag: "strong", Desc: "importance", Categories: []string{flow, phrasing}},
+	{Tag: "style", Desc: "embedded styling information", Categories: []string{metadata}},
+	{Tag: "sub", Desc: "a subscript", Categories: []string{flow, phrasing}},
+	{Tag: "summary", Desc: "the caption of details"},
+	{Tag: "sup", Desc: "a superscript", Categories: []string{flow, phrasing}},
+	{Tag: "table", Desc: "a table", Categories: []string{flow}},
+	{Tag: "tbody", Desc: "a group of rows in a table"},
+	{Tag: "td", Desc: "a table cell"},
+	{Tag: "template", Desc: "a template", Categories: []string{metadata, flow, phrasing, script}},
+	{Tag: "textarea", Desc: "a multiline text control", Categories: []string{flow, phrasing, interactive, form}},
+	{Tag: "tfoot", Desc: "a group of footer rows in a table"},
+	{Tag: "th", Desc: "a table header cell"},
+	{Tag: "thead", Desc: "a group of heading rows in a table"},
+	{Tag: "time", Desc: "a machine-readable date or time", Categories: []string{flow, phrasing}},
+	{Tag: "title", Desc: "the document title", Categories: []string{metadata}},
+	{Tag: "tr", Desc: "a table row"},
+	{Tag: "track", Desc: "a timed text track", Categories: []string{void}},
+	{Tag: "u", Desc: "an unarticulated annotation", Categories: []string{flow, phrasing}},
+	{Tag: "ul", Desc: "a list", Categories: []string{flow}},
+	{Tag: "var", Desc: "a variable", Categories: []string{flow, phrasing}},
+	{Tag: "video", Desc: "a video player", Categories: []string{flow, phrasing, embedded, interactive}},
+	{Tag: "wbr", Desc: "a line breaking opportunity", Categories: []string{flow, phrasing, void}},
+}
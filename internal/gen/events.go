@@ -0,0 +1,37 @@
+package main
+
+// events are the events with event handler content attributes: those of
+// GlobalEventHandlers and WindowEventHandlers in the WHATWG HTML standard,
+// plus pointer, touch, animation and transition events, which browsers
+// support as attributes too.
+var events = []string{
+	"abort", "afterprint", "animationcancel", "animationend",
+	"animationiteration", "animationstart", "auxclick", "beforeinput",
+	"beforematch", "beforeprint", "beforetoggle", "beforeunload", "blur",
+	"cancel", "canplay", "canplaythrough", "change", "click", "close",
+	"contextlost", "contextmenu", "contextrestored", "copy", "cuechange",
+	"cut", "dblclick", "drag", "dragend", "dragenter", "dragleave",
+	"dragover", "dragstart", "drop", "durationchange", "emptied", "ended",
+	"error", "focus", "focusin", "focusout", "formdata",
+	"gotpointercapture", "hashchange", "input", "invalid", "keydown",
+	"keypress", "keyup", "languagechange", "load", "loadeddata",
+	"loadedmetadata", "loadstart", "lostpointercapture", "message",
+	"messageerror", "mousedown", "mouseenter", "mouseleave", "mousemove",
+	"mouseout", "mouseover", "mouseup", "offline", "online", "pagehide",
+	"pagereveal", "pageshow", "pageswap", "paste", "pause", "play",
+	"playing", "pointercancel", "pointerdown", "pointerenter",
+	"pointerleave", "pointermove", "pointerout", "pointerover", "pointerup",
+	"popstate", "progress", "ratechange", "rejectionhandled", "reset",
+	"resize", "scroll", "scrollend", "securitypolicyviolation", "seeked",
+	"seeking", "select", "slotchange", "stalled", "storage", "submit",
+	"suspend", "timeupdate", "toggle", "touchcancel", "touchend",
+	"touchmove", "touchstart", "transitioncancel", "transitionend",
+	"transitionrun", "transitionstart", "unhandledrejection",
+	"volumechange", "waiting", "wheel",
+}
+
+// eventFuncs names the helpers that would clash with other identifiers:
+// OnError is the error hook of Try.
+var eventFuncs = map[string]string{
+	"error": "OnErrorAttr",
+}
@@ -0,0 +1,163 @@
+// Command gen generates the element constructors, attribute helpers and event
+// handler helpers of package ht, in elements_gen.go, attrs_gen.go and
+// events_gen.go, from the tables in elements.go, attributes.go and events.go.
+// Run it with go generate from the module root.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
+	"golang.org/x/net/html/atom"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("gen: ")
+
+	if err := generate("elements_gen.go", elementsTmpl, elements); err != nil {
+		log.Fatal(err)
+	}
+	if err := generate("attrs_gen.go", attributesTmpl, attributes); err != nil {
+		log.Fatal(err)
+	}
+	if err := generate("events_gen.go", eventsTmpl, events); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate executes tmpl with data and writes the formatted result to file.
+func generate(file string, tmpl *template.Template, data any) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %v", file, err)
+	}
+	return os.WriteFile(file, src, 0o644)
+}
+
+var funcs = template.FuncMap{
+	"atomName": atomName,
+	"funcName": funcName,
+	"join":     strings.Join,
+	"attrName": attrName,
+	"tags":     tags,
+	"title":    title,
+	"onName":   onName,
+}
+
+// atomName returns the name of the golang.org/x/net/html/atom constant for tag,
+// failing if the atom table does not have one.
+func atomName(tag string) (string, error) {
+	if atom.Lookup([]byte(tag)).String() != tag {
+		return "", fmt.Errorf("no atom for <%s>", tag)
+	}
+	return title(tag), nil
+}
+
+// funcName returns the constructor name for e.
+func funcName(e element) string {
+	if e.Name != "" {
+		return e.Name
+	}
+	return title(e.Tag)
+}
+
+// attrName returns the helper name for attr: the title-cased name, with
+// "Attr" appended if an element constructor already has that name.
+func attrName(attr attribute) string {
+	if attr.Func != "" {
+		return attr.Func
+	}
+	var name string
+	for part := range strings.SplitSeq(attr.Name, "-") {
+		name += title(part)
+	}
+	for _, e := range elements {
+		if funcName(e) == name {
+			return name + "Attr"
+		}
+	}
+	return name
+}
+
+// tags lists element names for a doc comment, e.g. "<a>, <area> and <link>".
+func tags(names []string) string {
+	s := make([]string, len(names))
+	for i, n := range names {
+		s[i] = "<" + n + ">"
+	}
+	if len(s) < 2 {
+		return strings.Join(s, "")
+	}
+	return strings.Join(s[:len(s)-1], ", ") + " and " + s[len(s)-1]
+}
+
+// onName returns the helper name for the handler of event.
+func onName(event string) string {
+	if name, ok := eventFuncs[event]; ok {
+		return name
+	}
+	return "On" + title(event)
+}
+
+func title(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+var elementsTmpl = template.Must(template.New("elements").Funcs(funcs).Parse(`// Code generated by "go run ./internal/gen"; DO NOT EDIT.
+
+package ht
+
+import (
+	h "golang.org/x/net/html"
+	a "golang.org/x/net/html/atom"
+)
+{{range .}}
+// {{funcName .}} constructs a new <{{.Tag}}> element: {{.Desc}}.
+func {{funcName .}}(args ...any) *h.Node { return Element(a.{{atomName .Tag}}, args...) }
+{{end}}
+var categories = map[a.Atom]ContentCategory{
+{{- range .}}{{if .Categories}}
+	a.{{atomName .Tag}}: {{join .Categories " | "}},{{end}}{{end}}
+}
+`))
+
+var attributesTmpl = template.Must(template.New("attributes").Funcs(funcs).Parse(`// Code generated by "go run ./internal/gen"; DO NOT EDIT.
+
+package ht
+
+import "golang.org/x/net/html"
+{{range .}}
+// {{attrName .}} sets the {{if not .Elements}}global {{end}}{{if .Bool}}boolean {{end}}{{.Name}} attribute
+{{- if .Elements}} of {{tags .Elements}}{{end}}: {{.Desc}}.
+{{- if .Bool}}
+func {{attrName .}}() html.Attribute { return Attr("{{.Name}}", "") }
+{{- else}}
+func {{attrName .}}(v string) html.Attribute { return Attr("{{.Name}}", v) }
+{{- end}}
+{{end}}
+// booleanAttrs are the HTML attributes whose presence alone means true.
+var booleanAttrs = map[string]bool{
+{{- range .}}{{if .Bool}}
+	"{{.Name}}": true,{{end}}{{end}}
+}
+`))
+
+var eventsTmpl = template.Must(template.New("events").Funcs(funcs).Parse(`// Code generated by "go run ./internal/gen"; DO NOT EDIT.
+
+package ht
+
+import "golang.org/x/net/html"
+{{range .}}
+// {{onName .}} sets the on{{.}} event handler attribute. See On.
+func {{onName .}}(js string, args ...any) html.Attribute { return On("{{.}}", js, args...) }
+{{end}}`))
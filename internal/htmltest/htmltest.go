@@ -0,0 +1,57 @@
+// Package htmltest compares html.Node trees, for the round-trip tests of the
+// svg and mathml packages.
+package htmltest
+
+import (
+	"fmt"
+
+	"golang.org/x/net/html"
+)
+
+// debugAttr is the attribute Render adds in builds with the htdebug tag.
+const debugAttr = "data-ht-src"
+
+// Find returns the first element in the given namespace with the given name,
+// in document order.
+func Find(n *html.Node, namespace, name string) *html.Node {
+	if n.Type == html.ElementNode && n.Namespace == namespace && n.Data == name {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if f := Find(c, namespace, name); f != nil {
+			return f
+		}
+	}
+	return nil
+}
+
+// Compare reports the first difference between the tree that was built and
+// the tree parsed back from its rendered output. Attributes added by htdebug
+// builds are ignored.
+func Compare(want, got *html.Node) error {
+	if got == nil {
+		return fmt.Errorf("missing <%s>", want.Data)
+	}
+	if got.Type != want.Type || got.Data != want.Data || got.DataAtom != want.DataAtom || got.Namespace != want.Namespace {
+		return fmt.Errorf("got %s:%q, want %s:%q", got.Namespace, got.Data, want.Namespace, want.Data)
+	}
+	var attrs []html.Attribute
+	for _, attr := range got.Attr {
+		if attr.Namespace != "" || attr.Key != debugAttr {
+			attrs = append(attrs, attr)
+		}
+	}
+	if fmt.Sprint(attrs) != fmt.Sprint(want.Attr) {
+		return fmt.Errorf("<%s>: got attributes %v, want %v", want.Data, attrs, want.Attr)
+	}
+	w, g := want.FirstChild, got.FirstChild
+	for ; w != nil && g != nil; w, g = w.NextSibling, g.NextSibling {
+		if err := Compare(w, g); err != nil {
+			return err
+		}
+	}
+	if w != nil || g != nil {
+		return fmt.Errorf("<%s>: child count differs", want.Data)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package ht
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	h "golang.org/x/net/html"
+)
+
+// LimitError is returned by Render and CheckLimits when a tree exceeds one of
+// the limits set with WithMaxDepth, WithMaxNodes or WithMaxBytes.
+type LimitError struct {
+	Limit string // "depth", "nodes" or "bytes"
+	Max   int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("ht: render exceeds the %s limit of %d", e.Limit, e.Max)
+}
+
+// WithMaxDepth stops rendering with a *LimitError when elements are nested
+// more than n deep. Fragments do not count towards the depth.
+func WithMaxDepth(n int) RenderOption {
+	return func(r *renderer) {
+		r.maxDepth = n
+	}
+}
+
+// WithMaxNodes stops rendering with a *LimitError once more than n nodes have
+// been written, including the content of lazy and deferred nodes.
+func WithMaxNodes(n int) RenderOption {
+	return func(r *renderer) {
+		r.maxNodes = n
+	}
+}
+
+// WithMaxBytes stops rendering with a *LimitError instead of writing more
+// than n bytes. Everything up to the write that would exceed the limit has
+// already been written.
+func WithMaxBytes(n int) RenderOption {
+	return func(r *renderer) {
+		r.maxBytes = n
+	}
+}
+
+// CheckLimits reports whether node can be rendered with opts without
+// exceeding the limits they set, returning a *LimitError if not. It renders
+// to nowhere and skips lazy, deferred and error-boundary content, which is
+// only known at render time; Render still enforces the limits there.
+func CheckLimits(node *h.Node, opts ...RenderOption) error {
+	return Render(context.Background(), io.Discard, node, append(opts, dryRun)...)
+}
+
+// dryRun makes Render skip dynamic content, for CheckLimits.
+func dryRun(r *renderer) {
+	r.dry = true
+}
+
+// limitWriter fails writes that would take it past max bytes.
+type limitWriter struct {
+	w   writer
+	n   int
+	max int
+}
+
+func (l *limitWriter) grow(n int) error {
+	if l.n+n > l.max {
+		return &LimitError{Limit: "bytes", Max: l.max}
+	}
+	l.n += n
+	return nil
+}
+
+func (l *limitWriter) Write(p []byte) (int, error) {
+	if err := l.grow(len(p)); err != nil {
+		return 0, err
+	}
+	return l.w.Write(p)
+}
+
+func (l *limitWriter) WriteString(s string) (int, error) {
+	if err := l.grow(len(s)); err != nil {
+		return 0, err
+	}
+	return l.w.WriteString(s)
+}
+
+func (l *limitWriter) WriteByte(c byte) error {
+	if err := l.grow(1); err != nil {
+		return err
+	}
+	return l.w.WriteByte(c)
+}
+
+// count enforces the node and depth limits for a node at the given depth.
+func (r *renderer) count(n *h.Node, depth int) error {
+	if r.maxNodes > 0 && n.Type != h.DocumentNode {
+		if r.nodes++; r.nodes > r.maxNodes {
+			return &LimitError{Limit: "nodes", Max: r.maxNodes}
+		}
+	}
+	if r.maxDepth > 0 && n.Type == h.ElementNode && depth+r.depthOffset >= r.maxDepth {
+		return &LimitError{Limit: "depth", Max: r.maxDepth}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestRenderLimits(t *testing.T) {
+	nested := Text("deep")
+	for range 10 {
+		nested = Div(nested)
+	}
+
+	for _, tt := range []struct {
+		opt   RenderOption
+		limit string
+	}{
+		{WithMaxDepth(5), "depth"},
+		{WithMaxNodes(5), "nodes"},
+		{WithMaxBytes(50), "bytes"},
+	} {
+		var lerr *LimitError
+		if err := CheckLimits(nested, tt.opt); !errors.As(err, &lerr) || lerr.Limit != tt.limit {
+			t.Errorf("CheckLimits: got %v, want %s limit error", err, tt.limit)
+		}
+		var buf bytes.Buffer
+		if err := Render(context.Background(), &buf, nested, tt.opt); !errors.As(err, &lerr) || lerr.Limit != tt.limit {
+			t.Errorf("Render: got %v, want %s limit error", err, tt.limit)
+		}
+		if n := len(stripSources(buf.String())); n > 50 {
+			t.Errorf("Render wrote %d bytes past the %s limit", n, tt.limit)
+		}
+	}
+
+	var full bytes.Buffer
+	if err := Render(context.Background(), &full, nested); err != nil {
+		t.Fatal(err)
+	}
+	if err := CheckLimits(nested, WithMaxDepth(10), WithMaxNodes(11), WithMaxBytes(full.Len())); err != nil {
+		t.Errorf("CheckLimits at the limits: %v", err)
+	}
+
+	deferred := Deferred(func(ctx context.Context) *html.Node { return Div(Div(Text("deep"))) }, nil)
+	for range 8 {
+		deferred = Div(deferred)
+	}
+	var lerr *LimitError
+	if err := Render(context.Background(), &bytes.Buffer{}, deferred, WithMaxDepth(9)); !errors.As(err, &lerr) || lerr.Limit != "depth" {
+		t.Errorf("Render with a deep Deferred result: got %v, want depth limit error", err)
+	}
+	if err := Render(context.Background(), &bytes.Buffer{}, deferred, WithMaxDepth(10)); err != nil {
+		t.Errorf("Render with a Deferred result at the limit: %v", err)
+	}
+}
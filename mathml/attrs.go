@@ -0,0 +1,41 @@
+package mathml
+
+import (
+	"strconv"
+
+	"github.com/accentdesign/ht"
+	h "golang.org/x/net/html"
+)
+
+// Xmlns declares the MathML namespace, for standalone documents. MathML in
+// HTML does not need it, and ht.WithXML adds it where required.
+func Xmlns() h.Attribute { return ht.Attr("xmlns", "http://www.w3.org/1998/Math/MathML") }
+
+func Display(v string) h.Attribute       { return ht.Attr("display", v) }
+func Displaystyle(on bool) h.Attribute   { return ht.Attr("displaystyle", strconv.FormatBool(on)) }
+func Mathvariant(v string) h.Attribute   { return ht.Attr("mathvariant", v) }
+func Scriptlevel(v string) h.Attribute   { return ht.Attr("scriptlevel", v) }
+func Linethickness(v string) h.Attribute { return ht.Attr("linethickness", v) }
+func Width(v string) h.Attribute         { return ht.Attr("width", v) }
+func Height(v string) h.Attribute        { return ht.Attr("height", v) }
+func Depth(v string) h.Attribute         { return ht.Attr("depth", v) }
+func Lspace(v string) h.Attribute        { return ht.Attr("lspace", v) }
+func Rspace(v string) h.Attribute        { return ht.Attr("rspace", v) }
+func Voffset(v string) h.Attribute       { return ht.Attr("voffset", v) }
+func Encoding(v string) h.Attribute      { return ht.Attr("encoding", v) }
+func Columnspan(n int) h.Attribute       { return ht.Attr("columnspan", strconv.Itoa(n)) }
+func Rowspan(n int) h.Attribute          { return ht.Attr("rowspan", strconv.Itoa(n)) }
+
+// operators
+
+func Form(v string) h.Attribute         { return ht.Attr("form", v) }
+func Fence(on bool) h.Attribute         { return ht.Attr("fence", strconv.FormatBool(on)) }
+func Separator(on bool) h.Attribute     { return ht.Attr("separator", strconv.FormatBool(on)) }
+func Stretchy(on bool) h.Attribute      { return ht.Attr("stretchy", strconv.FormatBool(on)) }
+func Symmetric(on bool) h.Attribute     { return ht.Attr("symmetric", strconv.FormatBool(on)) }
+func Largeop(on bool) h.Attribute       { return ht.Attr("largeop", strconv.FormatBool(on)) }
+func Movablelimits(on bool) h.Attribute { return ht.Attr("movablelimits", strconv.FormatBool(on)) }
+func Minsize(v string) h.Attribute      { return ht.Attr("minsize", v) }
+func Maxsize(v string) h.Attribute      { return ht.Attr("maxsize", v) }
+func Accent(on bool) h.Attribute        { return ht.Attr("accent", strconv.FormatBool(on)) }
+func Accentunder(on bool) h.Attribute   { return ht.Attr("accentunder", strconv.FormatBool(on)) }
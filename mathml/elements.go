@@ -0,0 +1,64 @@
+// Package mathml provides constructors for MathML, which browsers render
+// natively. Elements are created in the "math" namespace, as html.Parse
+// creates them, and take the same arguments as ht.Element:
+//
+//	mathml.Math(
+//		mathml.Mfrac(mathml.Mi("a"), mathml.Mn("2")),
+//	)
+package mathml
+
+import (
+	"github.com/accentdesign/ht"
+	h "golang.org/x/net/html"
+)
+
+// Element constructs a MathML element with the given name, for elements this
+// package has no constructor for.
+func Element(name string, args ...any) *h.Node { return ht.ElementNS("math", name, args...) }
+
+// Math is the root of a formula. Add Display("block") to show it on its own
+// line.
+func Math(args ...any) *h.Node { return Element("math", args...) }
+
+// tokens
+
+func Mi(args ...any) *h.Node     { return Element("mi", args...) }
+func Mn(args ...any) *h.Node     { return Element("mn", args...) }
+func Mo(args ...any) *h.Node     { return Element("mo", args...) }
+func Ms(args ...any) *h.Node     { return Element("ms", args...) }
+func Mspace(args ...any) *h.Node { return Element("mspace", args...) }
+func Mtext(args ...any) *h.Node  { return Element("mtext", args...) }
+
+// layout
+
+func Merror(args ...any) *h.Node   { return Element("merror", args...) }
+func Mfrac(args ...any) *h.Node    { return Element("mfrac", args...) }
+func Mpadded(args ...any) *h.Node  { return Element("mpadded", args...) }
+func Mphantom(args ...any) *h.Node { return Element("mphantom", args...) }
+func Mroot(args ...any) *h.Node    { return Element("mroot", args...) }
+func Mrow(args ...any) *h.Node     { return Element("mrow", args...) }
+func Msqrt(args ...any) *h.Node    { return Element("msqrt", args...) }
+func Mstyle(args ...any) *h.Node   { return Element("mstyle", args...) }
+
+// scripts and limits
+
+func Mmultiscripts(args ...any) *h.Node { return Element("mmultiscripts", args...) }
+func Mover(args ...any) *h.Node         { return Element("mover", args...) }
+func Mprescripts(args ...any) *h.Node   { return Element("mprescripts", args...) }
+func Msub(args ...any) *h.Node          { return Element("msub", args...) }
+func Msubsup(args ...any) *h.Node       { return Element("msubsup", args...) }
+func Msup(args ...any) *h.Node          { return Element("msup", args...) }
+func Munder(args ...any) *h.Node        { return Element("munder", args...) }
+func Munderover(args ...any) *h.Node    { return Element("munderover", args...) }
+
+// tables
+
+func Mtable(args ...any) *h.Node { return Element("mtable", args...) }
+func Mtd(args ...any) *h.Node    { return Element("mtd", args...) }
+func Mtr(args ...any) *h.Node    { return Element("mtr", args...) }
+
+// semantics
+
+func Annotation(args ...any) *h.Node    { return Element("annotation", args...) }
+func AnnotationXml(args ...any) *h.Node { return Element("annotation-xml", args...) }
+func Semantics(args ...any) *h.Node     { return Element("semantics", args...) }
@@ -0,0 +1,78 @@
+package mathml
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/accentdesign/ht"
+	"github.com/accentdesign/ht/internal/htmltest"
+	"golang.org/x/net/html"
+)
+
+func formulas() map[string]func() *html.Node {
+	return map[string]func() *html.Node{
+		"quadratic": func() *html.Node {
+			return Math(Display("block"),
+				Mrow(
+					Mi("x"), Mo("="),
+					Mfrac(
+						Mrow(Mo("−"), Mi("b"), Mo("±"),
+							Msqrt(Msup(Mi("b"), Mn("2")), Mo("−"), Mn("4"), Mi("a"), Mi("c"))),
+						Mrow(Mn("2"), Mi("a")),
+					),
+				),
+			)
+		},
+		"sum": func() *html.Node {
+			return Math(
+				Munderover(Mo(Largeop(true), Movablelimits(false), "∑"), Mrow(Mi("i"), Mo("="), Mn("1")), Mi("n")),
+				Msubsup(Mi("x"), Mi("i"), Mn("2")),
+				Mroot(Mi("y"), Mn("3")),
+				Mmultiscripts(Mi("C"), Mi("k"), Mrow(), Mprescripts(), Mi("n"), Mrow()),
+			)
+		},
+		"matrix": func() *html.Node {
+			return Math(Mrow(
+				Mo(Fence(true), Stretchy(true), "("),
+				Mtable(
+					Mtr(Mtd(Mn("1")), Mtd(Mn("0"))),
+					Mtr(Mtd(Columnspan(2), Mtext("a & b"))),
+				),
+				Mo(Fence(true), Stretchy(true), ")"),
+			))
+		},
+		"semantics": func() *html.Node {
+			return Math(Semantics(
+				Mrow(Mi(Mathvariant("bold"), "v"), Mspace(Width("1em")), Mpadded(Lspace("2px"), Mi("w"))),
+				Annotation(Encoding("application/x-tex"), `\mathbf{v}\,w`),
+			))
+		},
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	renderers := map[string]func(io.Writer, *html.Node) error{
+		"html.Render": html.Render,
+		"ht.Render": func(w io.Writer, n *html.Node) error {
+			return ht.Render(context.Background(), w, n)
+		},
+	}
+	for name, build := range formulas() {
+		for rname, render := range renderers {
+			var buf bytes.Buffer
+			if err := render(&buf, ht.P(build())); err != nil {
+				t.Fatal(err)
+			}
+			doc, err := html.Parse(strings.NewReader(buf.String()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := htmltest.Compare(build(), htmltest.Find(doc, "math", "math")); err != nil {
+				t.Errorf("%s with %s: %v\nrendered: %s", name, rname, err, buf.String())
+			}
+		}
+	}
+}
@@ -0,0 +1,311 @@
+package ht
+
+import (
+	"strings"
+
+	h "golang.org/x/net/html"
+)
+
+// WithMinify renders the smallest markup that parses to the same document:
+// optional end tags such as </li>, </p> and </td> are omitted, attribute
+// values that need no quotes are written bare, boolean attributes such as
+// Disabled() are written as just their name, void elements are closed with
+// ">" instead of "/>", and runs of inter-element whitespace are collapsed
+// everywhere except inside Pre, Textarea, Script and Style.
+//
+// Minifying takes precedence over WithIndent and WithNewline.
+func WithMinify() RenderOption {
+	return func(r *renderer) {
+		r.minify = true
+	}
+}
+
+// asciiSpace is the set of ASCII whitespace characters defined by the WHATWG
+// infra standard.
+const asciiSpace = " \t\n\f\r"
+
+// unquotedUnsafe are the characters that may not appear in an unquoted
+// attribute value, in addition to whitespace.
+const unquotedUnsafe = asciiSpace + "\"'=<>`"
+
+// minifyAttr writes attr in its shortest form.
+func (r *renderer) minifyAttr(attr h.Attribute) error {
+	if err := r.w.WriteByte(' '); err != nil {
+		return err
+	}
+	if attr.Namespace != "" {
+		if _, err := r.w.WriteString(attr.Namespace); err != nil {
+			return err
+		}
+		if err := r.w.WriteByte(':'); err != nil {
+			return err
+		}
+	}
+	if _, err := r.w.WriteString(attr.Key); err != nil {
+		return err
+	}
+	if attr.Val == "" {
+		return nil
+	}
+	if strings.ContainsAny(attr.Val, unquotedUnsafe) {
+		if _, err := r.w.WriteString(`="`); err != nil {
+			return err
+		}
+		if err := escape(r.w, attr.Val); err != nil {
+			return err
+		}
+		return r.w.WriteByte('"')
+	}
+	if err := r.w.WriteByte('='); err != nil {
+		return err
+	}
+	return escape(r.w, attr.Val)
+}
+
+// minifyText writes a text node outside preformatted content with every run of
+// whitespace collapsed to a single space. Whitespace-only nodes are dropped
+// entirely where the browser would ignore them anyway.
+func (r *renderer) minifyText(n *h.Node) error {
+	if isSpace(n.Data) {
+		if droppableSpace(n) {
+			return nil
+		}
+		return r.w.WriteByte(' ')
+	}
+
+	s := n.Data
+	for {
+		i := strings.IndexAny(s, asciiSpace)
+		if i == -1 {
+			break
+		}
+		j := i + 1
+		for j < len(s) && strings.IndexByte(asciiSpace, s[j]) != -1 {
+			j++
+		}
+		if err := escape(r.w, s[:i]); err != nil {
+			return err
+		}
+		if err := r.w.WriteByte(' '); err != nil {
+			return err
+		}
+		s = s[j:]
+	}
+	return escape(r.w, s)
+}
+
+// isSpace reports whether s consists only of ASCII whitespace.
+func isSpace(s string) bool {
+	return strings.Trim(s, asciiSpace) == ""
+}
+
+// droppableSpace reports whether the whitespace-only text node n can be
+// removed without changing how the document is displayed. That is the case
+// when its parent does not allow text at all, or when it sits between two
+// block-level siblings (or at the edge of its parent, next to one).
+func droppableSpace(n *h.Node) bool {
+	parent := parentElement(n)
+	if parent == nil {
+		return false
+	}
+	if noTextContent[parent.Data] {
+		return true
+	}
+
+	prev, next := preceding(n, true), following(n, true)
+	if prev == nil && next == nil {
+		return blockElements[parent.Data]
+	}
+	return isBlock(prev) && isBlock(next)
+}
+
+func isBlock(n *h.Node) bool {
+	return n == nil || (n.Type == h.ElementNode && blockElements[n.Data]) || n.Type == h.CommentNode
+}
+
+// omitEndTag reports whether the end tag of n is optional in its position, per
+// WHATWG HTML 13.1.2.4. The tag is only omitted when n has a parent element,
+// so that partials written back to back into the same response stay intact.
+func omitEndTag(n *h.Node) bool {
+	parent := parentElement(n)
+	if parent == nil || n.Namespace != "" {
+		return false
+	}
+	next := following(n, true)
+
+	switch n.Data {
+	case "html", "body":
+		return next == nil || next.Type != h.CommentNode
+	case "head", "colgroup", "caption":
+		return !spaceOrComment(following(n, false))
+	case "li":
+		return next == nil || isElement(next, "li")
+	case "dt":
+		return isElement(next, "dt", "dd")
+	case "dd":
+		return next == nil || isElement(next, "dt", "dd")
+	case "p":
+		if next == nil {
+			switch parent.Data {
+			case "a", "audio", "del", "ins", "map", "noscript", "video":
+				return false
+			}
+			return !strings.Contains(parent.Data, "-")
+		}
+		return next.Type == h.ElementNode && next.Namespace == "" && closesP[next.Data]
+	case "rt", "rp":
+		return next == nil || isElement(next, "rt", "rp")
+	case "optgroup":
+		return next == nil || isElement(next, "optgroup")
+	case "option":
+		return next == nil || isElement(next, "option", "optgroup")
+	case "thead":
+		return isElement(next, "tbody", "tfoot")
+	case "tbody":
+		return next == nil || isElement(next, "tbody", "tfoot")
+	case "tfoot":
+		return next == nil
+	case "tr":
+		return next == nil || isElement(next, "tr")
+	case "td", "th":
+		return next == nil || isElement(next, "td", "th")
+	}
+	return false
+}
+
+// spaceOrComment reports whether n is a comment or text starting with
+// whitespace.
+func spaceOrComment(n *h.Node) bool {
+	if n == nil {
+		return false
+	}
+	return n.Type == h.CommentNode || (n.Type == h.TextNode && n.Data != "" && strings.IndexByte(asciiSpace, n.Data[0]) != -1)
+}
+
+func isElement(n *h.Node, tags ...string) bool {
+	if n == nil || n.Type != h.ElementNode || n.Namespace != "" {
+		return false
+	}
+	for _, tag := range tags {
+		if n.Data == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// parentElement returns the nearest ancestor of n that is an element, looking
+// through fragments, or nil if there is none.
+func parentElement(n *h.Node) *h.Node {
+	for p := n.Parent; p != nil; p = p.Parent {
+		switch p.Type {
+		case h.ElementNode:
+			return p
+		case h.DocumentNode:
+			continue
+		}
+		return nil
+	}
+	return nil
+}
+
+// following returns the node rendered immediately after n inside the same
+// element, looking through fragments, or nil if n is the last one. When
+// skipSpace is set, whitespace-only text nodes are passed over.
+func following(n *h.Node, skipSpace bool) *h.Node {
+	for {
+		for s := n.NextSibling; s != nil; s = s.NextSibling {
+			if c := firstRendered(s, skipSpace); c != nil {
+				return c
+			}
+		}
+		p := n.Parent
+		if p == nil || p.Type != h.DocumentNode {
+			return nil
+		}
+		n = p
+	}
+}
+
+// preceding is the mirror image of following.
+func preceding(n *h.Node, skipSpace bool) *h.Node {
+	for {
+		for s := n.PrevSibling; s != nil; s = s.PrevSibling {
+			if c := lastRendered(s, skipSpace); c != nil {
+				return c
+			}
+		}
+		p := n.Parent
+		if p == nil || p.Type != h.DocumentNode {
+			return nil
+		}
+		n = p
+	}
+}
+
+func firstRendered(n *h.Node, skipSpace bool) *h.Node {
+	switch {
+	case n.Type == h.DocumentNode:
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if f := firstRendered(c, skipSpace); f != nil {
+				return f
+			}
+		}
+		return nil
+	case skipSpace && n.Type == h.TextNode && isSpace(n.Data):
+		return nil
+	}
+	return n
+}
+
+func lastRendered(n *h.Node, skipSpace bool) *h.Node {
+	switch {
+	case n.Type == h.DocumentNode:
+		for c := n.LastChild; c != nil; c = c.PrevSibling {
+			if l := lastRendered(c, skipSpace); l != nil {
+				return l
+			}
+		}
+		return nil
+	case skipSpace && n.Type == h.TextNode && isSpace(n.Data):
+		return nil
+	}
+	return n
+}
+
+// closesP are the elements whose start tag implicitly closes an open <p>.
+var closesP = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"details": true, "dialog": true, "div": true, "dl": true,
+	"fieldset": true, "figcaption": true, "figure": true, "footer": true,
+	"form": true, "h1": true, "h2": true, "h3": true, "h4": true, "h5": true,
+	"h6": true, "header": true, "hgroup": true, "hr": true, "main": true,
+	"menu": true, "nav": true, "ol": true, "p": true, "pre": true,
+	"search": true, "section": true, "table": true, "ul": true,
+}
+
+// noTextContent are the elements whose content model has no place for text,
+// so whitespace between their children is never displayed.
+var noTextContent = map[string]bool{
+	"colgroup": true, "datalist": true, "dl": true, "head": true,
+	"html": true, "menu": true, "ol": true, "optgroup": true,
+	"select": true, "table": true, "tbody": true, "tfoot": true,
+	"thead": true, "tr": true, "ul": true,
+}
+
+// blockElements are displayed as blocks by default, so whitespace next to
+// them does not produce a visible space.
+var blockElements = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true,
+	"body": true, "br": true, "caption": true, "dd": true, "details": true,
+	"dialog": true, "div": true, "dl": true, "dt": true, "fieldset": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"head": true, "header": true, "hgroup": true, "hr": true, "html": true,
+	"li": true, "link": true, "main": true, "menu": true, "meta": true,
+	"nav": true, "ol": true, "option": true, "p": true, "pre": true,
+	"script": true, "search": true, "section": true, "style": true,
+	"summary": true, "table": true, "tbody": true, "td": true,
+	"template": true, "tfoot": true, "th": true, "thead": true,
+	"title": true, "tr": true, "ul": true,
+}
@@ -0,0 +1,76 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestRenderMinifyParsesToSameDocument(t *testing.T) {
+	page := func() *html.Node {
+		return Document(
+			Doctype("html"),
+			Html(
+				Head(Meta(Charset("utf-8")), Title(Text("Minify")), Script(Raw("a  <  b"))),
+				Body(
+					Text("\n\t"),
+					Ul(Fragment(Text("\n\t\t"), Li(Text("one")), Text("\n\t\t"), Li(Text("two")))),
+					P(Text("first")),
+					P(Text("second "), B(Text("bold"))),
+					Table(Thead(Tr(Th(Text("a")))), Tbody(Tr(Td(Colspan("2"), Text("1"))))),
+					Pre(Text("\n  keep   this")),
+					Select(Option(Value("a b"), Selected(), Text("A")), Option(Text("B"))),
+					Input(Type("text"), Disabled(), Value("a/b")),
+					Text("\n"),
+				),
+			),
+		)
+	}
+
+	var full, min bytes.Buffer
+	if err := Render(context.Background(), &full, page()); err != nil {
+		t.Fatal(err)
+	}
+	if err := Render(context.Background(), &min, page(), WithMinify()); err != nil {
+		t.Fatal(err)
+	}
+	if min.Len() >= full.Len() {
+		t.Errorf("minified output is %d bytes, want fewer than %d", min.Len(), full.Len())
+	}
+
+	want := reparse(t, full.String())
+	if got := reparse(t, min.String()); got != want {
+		t.Errorf("minified output parses differently\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+// reparse parses s as a document and renders it back without whitespace-only
+// text nodes, normalizing the markup.
+func reparse(t *testing.T, s string) string {
+	t.Helper()
+	doc, err := html.Parse(strings.NewReader(s))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var strip func(n *html.Node)
+	strip = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; {
+			next := c.NextSibling
+			if c.Type == html.TextNode && strings.TrimSpace(c.Data) == "" {
+				n.RemoveChild(c)
+			} else {
+				strip(c)
+			}
+			c = next
+		}
+	}
+	strip(doc)
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
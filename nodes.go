@@ -113,6 +113,12 @@ func Apply(node *h.Node, args ...any) *h.Node {
 			}
 		case []any:
 			Apply(node, v...)
+		case Attributer:
+			Apply(node, v.Attribute())
+		case *StaticNode:
+			if v != nil {
+				node.AppendChild(v.Node())
+			}
 		case string:
 			node.AppendChild(Text(v))
 		case *string:
@@ -147,10 +153,28 @@ func Apply(node *h.Node, args ...any) *h.Node {
 //     already has a parent or siblings. Detach the node from its current
 //     parent (e.g. parent.RemoveChild(n)) before passing it here, or clone it
 //     if you need to keep the original in place.
+//   - Attributer: the attribute it returns is added as above, e.g. for typed
+//     values such as InputCheckbox or MethodPost.
+//   - *StaticNode: appended as its pre-rendered RawNode.
 //   - string, *string, fmt.Stringer, error, or any other type: coerced to text
 //     via Text(...).
+//
+// In builds with the htdebug tag, Element also records the file, line and
+// function of its caller, which Render writes out as a data-ht-src attribute
+// (or a comment, see WithSourceComments).
 func Element(tag a.Atom, args ...any) *h.Node {
 	node := &h.Node{Type: h.ElementNode, DataAtom: tag, Data: tag.String()}
+	recordSource(node)
+	return Apply(node, args...)
+}
+
+// ElementNS constructs an element in a foreign namespace, "svg" or "math",
+// with the same variadic args as Element. The name must be spelled the way
+// html.Parse spells it, e.g. "linearGradient", so that rendered output parses
+// back into the same tree. The svg and mathml packages build on it.
+func ElementNS(namespace, name string, args ...any) *h.Node {
+	node := &h.Node{Type: h.ElementNode, Namespace: namespace, DataAtom: a.Lookup([]byte(name)), Data: name}
+	recordSource(node)
 	return Apply(node, args...)
 }
 
@@ -164,80 +188,6 @@ func Text(data string) *h.Node {
 	return &h.Node{Type: h.TextNode, Data: data}
 }
 
-func A(args ...any) *h.Node          { return Element(a.A, args...) }
-func Abbr(args ...any) *h.Node       { return Element(a.Abbr, args...) }
-func Address(args ...any) *h.Node    { return Element(a.Address, args...) }
-func Article(args ...any) *h.Node    { return Element(a.Article, args...) }
-func Aside(args ...any) *h.Node      { return Element(a.Aside, args...) }
-func B(args ...any) *h.Node          { return Element(a.B, args...) }
-func Blockquote(args ...any) *h.Node { return Element(a.Blockquote, args...) }
-func Body(args ...any) *h.Node       { return Element(a.Body, args...) }
-func Button(args ...any) *h.Node     { return Element(a.Button, args...) }
-func Br(args ...any) *h.Node         { return Element(a.Br, args...) }
-func Caption(args ...any) *h.Node    { return Element(a.Caption, args...) }
-func Cite(args ...any) *h.Node       { return Element(a.Cite, args...) }
-func Code(args ...any) *h.Node       { return Element(a.Code, args...) }
-func Col(args ...any) *h.Node        { return Element(a.Col, args...) }
-func Colgroup(args ...any) *h.Node   { return Element(a.Colgroup, args...) }
-func Dd(args ...any) *h.Node         { return Element(a.Dd, args...) }
-func Details(args ...any) *h.Node    { return Element(a.Details, args...) }
-func Dialog(args ...any) *h.Node     { return Element(a.Dialog, args...) }
-func Div(args ...any) *h.Node        { return Element(a.Div, args...) }
-func Dl(args ...any) *h.Node         { return Element(a.Dl, args...) }
-func Dt(args ...any) *h.Node         { return Element(a.Dt, args...) }
-func Em(args ...any) *h.Node         { return Element(a.Em, args...) }
-func Fieldset(args ...any) *h.Node   { return Element(a.Fieldset, args...) }
-func Figcaption(args ...any) *h.Node { return Element(a.Figcaption, args...) }
-func Figure(args ...any) *h.Node     { return Element(a.Figure, args...) }
-func Footer(args ...any) *h.Node     { return Element(a.Footer, args...) }
-func Form(args ...any) *h.Node       { return Element(a.Form, args...) }
-func H1(args ...any) *h.Node         { return Element(a.H1, args...) }
-func H2(args ...any) *h.Node         { return Element(a.H2, args...) }
-func H3(args ...any) *h.Node         { return Element(a.H3, args...) }
-func H4(args ...any) *h.Node         { return Element(a.H4, args...) }
-func H5(args ...any) *h.Node         { return Element(a.H5, args...) }
-func Head(args ...any) *h.Node       { return Element(a.Head, args...) }
-func Header(args ...any) *h.Node     { return Element(a.Header, args...) }
-func Hr(args ...any) *h.Node         { return Element(a.Hr, args...) }
-func Html(args ...any) *h.Node       { return Element(a.Html, args...) }
-func I(args ...any) *h.Node          { return Element(a.I, args...) }
-func Img(args ...any) *h.Node        { return Element(a.Img, args...) }
-func Input(args ...any) *h.Node      { return Element(a.Input, args...) }
-func Label(args ...any) *h.Node      { return Element(a.Label, args...) }
-func Legend(args ...any) *h.Node     { return Element(a.Legend, args...) }
-func Li(args ...any) *h.Node         { return Element(a.Li, args...) }
-func Link(args ...any) *h.Node       { return Element(a.Link, args...) }
-func Main(args ...any) *h.Node       { return Element(a.Main, args...) }
-func Mark(args ...any) *h.Node       { return Element(a.Mark, args...) }
-func Meta(args ...any) *h.Node       { return Element(a.Meta, args...) }
-func Nav(args ...any) *h.Node        { return Element(a.Nav, args...) }
-func Ol(args ...any) *h.Node         { return Element(a.Ol, args...) }
-func Optgroup(args ...any) *h.Node   { return Element(a.Optgroup, args...) }
-func Option(args ...any) *h.Node     { return Element(a.Option, args...) }
-func P(args ...any) *h.Node          { return Element(a.P, args...) }
-func Pre(args ...any) *h.Node        { return Element(a.Pre, args...) }
-func Script(args ...any) *h.Node     { return Element(a.Script, args...) }
-func Section(args ...any) *h.Node    { return Element(a.Section, args...) }
-func Select(args ...any) *h.Node     { return Element(a.Select, args...) }
-func Small(args ...any) *h.Node      { return Element(a.Small, args...) }
-func Span(args ...any) *h.Node       { return Element(a.Span, args...) }
-func Strong(args ...any) *h.Node     { return Element(a.Strong, args...) }
-func Style(args ...any) *h.Node      { return Element(a.Style, args...) }
-func Sub(args ...any) *h.Node        { return Element(a.Sub, args...) }
-func Summary(args ...any) *h.Node    { return Element(a.Summary, args...) }
-func Sup(args ...any) *h.Node        { return Element(a.Sup, args...) }
-func Table(args ...any) *h.Node      { return Element(a.Table, args...) }
-func Tbody(args ...any) *h.Node      { return Element(a.Tbody, args...) }
-func Td(args ...any) *h.Node         { return Element(a.Td, args...) }
-func Template(args ...any) *h.Node   { return Element(a.Template, args...) }
-func Textarea(args ...any) *h.Node   { return Element(a.Textarea, args...) }
-func Tfoot(args ...any) *h.Node      { return Element(a.Tfoot, args...) }
-func Th(args ...any) *h.Node         { return Element(a.Th, args...) }
-func Thead(args ...any) *h.Node      { return Element(a.Thead, args...) }
-func Title(args ...any) *h.Node      { return Element(a.Title, args...) }
-func Tr(args ...any) *h.Node         { return Element(a.Tr, args...) }
-func Ul(args ...any) *h.Node         { return Element(a.Ul, args...) }
-
 // If returns the provided value if the condition is true; otherwise, it returns nil.
 // This works universally for nodes, attributes, slices, or any other value.
 func If(cond bool, v any) any {
@@ -0,0 +1,63 @@
+package ht
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	h "golang.org/x/net/html"
+)
+
+// Parallel builds independent children concurrently and returns them as a
+// Fragment, in the order the producers were given. Nil results are skipped.
+//
+// When a producer fails, the context passed to the others is cancelled and
+// Parallel returns every error that occurred, combined with errors.Join.
+// Errors the other producers return only because of that cancellation are left
+// out. A producer that panics fails with a *PanicError.
+//
+// Each result must be detached, as required by Element: a node that still has
+// a parent or siblings is reported as an error instead of being spliced in.
+func Parallel(ctx context.Context, producers ...func(ctx context.Context) (*h.Node, error)) (*h.Node, error) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	nodes := make([]*h.Node, len(producers))
+	errs := make([]error, len(producers))
+
+	var wg sync.WaitGroup
+	for i, produce := range producers {
+		wg.Go(func() {
+			var n *h.Node
+			err := recovered(func() (err error) {
+				n, err = produce(ctx)
+				return err
+			})
+			if err == nil && n != nil && (n.Parent != nil || n.PrevSibling != nil || n.NextSibling != nil) {
+				err = fmt.Errorf("ht: parallel producer %d returned a node that is not detached", i)
+			}
+			if err != nil {
+				errs[i] = err
+				cancel(errParallel)
+				return
+			}
+			nodes[i] = n
+		})
+	}
+	wg.Wait()
+
+	var failed []error
+	for _, err := range errs {
+		if err != nil && !(errors.Is(err, context.Canceled) && context.Cause(ctx) == errParallel) {
+			failed = append(failed, err)
+		}
+	}
+	if len(failed) > 0 {
+		return nil, errors.Join(failed...)
+	}
+	return Fragment(nodes), nil
+}
+
+// errParallel is the cancellation cause Parallel uses once a producer fails.
+var errParallel = errors.New("ht: parallel producer failed")
@@ -0,0 +1,46 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestParallel(t *testing.T) {
+	ok := func(s string) func(context.Context) (*html.Node, error) {
+		return func(context.Context) (*html.Node, error) { return P(Text(s)), nil }
+	}
+
+	frag, err := Parallel(context.Background(), ok("a"), ok("b"), ok("c"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, Div(frag)); err != nil {
+		t.Fatal(err)
+	}
+	if want := "<div><p>a</p><p>b</p><p>c</p></div>"; stripSources(buf.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(buf.String()), want)
+	}
+
+	boom := errors.New("boom")
+	_, err = Parallel(context.Background(),
+		func(ctx context.Context) (*html.Node, error) {
+			<-ctx.Done()
+			return nil, ctx.Err()
+		},
+		func(context.Context) (*html.Node, error) { return nil, boom },
+	)
+	if !errors.Is(err, boom) || errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want only %v", err, boom)
+	}
+
+	_, err = Parallel(context.Background(), ok("a"), func(context.Context) (*html.Node, error) { panic("boom") })
+	var perr *PanicError
+	if !errors.As(err, &perr) || perr.Value != "boom" {
+		t.Errorf("got error %v, want the panic", err)
+	}
+}
@@ -0,0 +1,600 @@
+package ht
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	h "golang.org/x/net/html"
+)
+
+// writer is the subset of *bufio.Writer, *bytes.Buffer and *strings.Builder
+// that the renderer writes to.
+type writer interface {
+	io.Writer
+	io.ByteWriter
+	WriteString(string) (int, error)
+}
+
+// RenderOption configures a call to Render.
+type RenderOption func(*renderer)
+
+// WithIndent pretty-prints the output. Elements whose children are all
+// block-level elements or comments place each child on its own line, prefixed
+// by one copy of indent per level of nesting. Elements containing text or
+// phrasing content, such as <b>, <a> or <img>, are left inline so that no
+// significant whitespace is introduced.
+func WithIndent(indent string) RenderOption {
+	return func(r *renderer) {
+		r.indent = indent
+		r.pretty = true
+	}
+}
+
+// WithNewline sets the line terminator used when pretty-printing and enables
+// pretty-printing if it was not already. The default is "\n".
+func WithNewline(newline string) RenderOption {
+	return func(r *renderer) {
+		r.newline = newline
+		r.pretty = true
+	}
+}
+
+// renderer holds the state of a single Render call.
+type renderer struct {
+	w    writer
+	out  io.Writer     // the writer passed to Render
+	buf  *bufio.Writer // buffers out when it is not a writer itself
+	done <-chan struct{}
+	ctx  context.Context
+
+	pretty  bool
+	indent  string
+	newline string
+	fresh   bool // nothing has been written yet
+
+	minify   bool
+	preserve int // depth inside elements whose whitespace is significant
+
+	xml   bool
+	scope xmlScope
+
+	visitors []Visitor
+
+	sourceComments bool
+
+	maxDepth, maxNodes, maxBytes int
+	nodes                        int  // nodes written so far
+	depthOffset                  int  // added to the depth of Deferred results
+	dry                          bool // skip dynamic content, for CheckLimits
+
+	// Deferred producers run on goroutines under async, which is cancelled
+	// when Render returns, and report back on results.
+	async     context.Context
+	stopAsync context.CancelFunc
+	results   chan deferredResult
+	pending   int
+}
+
+// errPlaintext is returned when a <plaintext> element has been rendered.
+// Nothing, not even end tags, may be written after it.
+var errPlaintext = errors.New("ht: internal error (plaintext abort)")
+
+// Render serializes node to w.
+//
+// Without options the output is byte-identical to html.Render. Rendering
+// stops with ctx's error as soon as ctx is cancelled, so a large page is not
+// written in full to a client that has already gone away.
+func Render(ctx context.Context, w io.Writer, node *h.Node, opts ...RenderOption) error {
+	r := &renderer{ctx: ctx, done: ctx.Done(), newline: "\n", fresh: true}
+	for _, opt := range opts {
+		opt(r)
+	}
+	if r.xml {
+		r.minify = false
+	}
+	if r.minify {
+		r.pretty = false
+	}
+
+	defer func() {
+		if r.stopAsync != nil {
+			r.stopAsync()
+		}
+	}()
+
+	r.out = w
+	if x, ok := w.(writer); ok {
+		r.w = x
+	} else {
+		r.buf = bufio.NewWriter(w)
+		r.w = r.buf
+	}
+	if r.maxBytes > 0 {
+		r.w = &limitWriter{w: r.w, max: r.maxBytes}
+	}
+
+	if err := r.render(node); err != nil {
+		return err
+	}
+	if r.buf != nil {
+		return r.buf.Flush()
+	}
+	return nil
+}
+
+func (r *renderer) render(n *h.Node) error {
+	err := r.node(n, 0)
+	if err == errPlaintext {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return r.resolve()
+}
+
+// cancelled reports the context error once the render context is done.
+func (r *renderer) cancelled() error {
+	if r.done == nil {
+		return nil
+	}
+	select {
+	case <-r.done:
+		return r.ctx.Err()
+	default:
+		return nil
+	}
+}
+
+func (r *renderer) node(n *h.Node, depth int) error {
+	if err := r.cancelled(); err != nil {
+		return err
+	}
+	if len(r.visitors) > 0 {
+		if n = r.visit(n); n == nil {
+			return nil
+		}
+	}
+	if err := r.count(n, depth); err != nil {
+		return err
+	}
+	return r.emit(n, depth)
+}
+
+// emit writes n, which has already been through the visitors.
+func (r *renderer) emit(n *h.Node, depth int) error {
+	switch n.Type {
+	case flushNode, lazyNode, deferredNode, tryNode:
+		if r.dry {
+			return nil
+		}
+	case h.DocumentNode:
+	default:
+		r.fresh = false
+	}
+
+	switch n.Type {
+	case h.ErrorNode:
+		return errors.New("ht: cannot render an ErrorNode node")
+	case h.TextNode:
+		if r.xml {
+			if err := checkXMLChars(n.Data); err != nil {
+				return err
+			}
+		}
+		if r.minify && r.preserve == 0 {
+			return r.minifyText(n)
+		}
+		return escape(r.w, n.Data)
+	case h.DocumentNode:
+		return r.children(n, depth, r.pretty && blockContent(n))
+	case h.ElementNode:
+		return r.element(n, depth)
+	case h.CommentNode:
+		if r.xml {
+			if err := xmlComment(n); err != nil {
+				return err
+			}
+		}
+		if _, err := r.w.WriteString("<!--"); err != nil {
+			return err
+		}
+		if err := escapeComment(r.w, n.Data); err != nil {
+			return err
+		}
+		_, err := r.w.WriteString("-->")
+		return err
+	case h.DoctypeNode:
+		return r.doctype(n)
+	case h.RawNode:
+		_, err := r.w.WriteString(n.Data)
+		return err
+	case flushNode:
+		return r.flush()
+	case lazyNode:
+		return r.lazy(n, depth)
+	case deferredNode:
+		return r.deferred(n, depth)
+	case tryNode:
+		return r.try(n, depth)
+	default:
+		return errors.New("ht: unknown node type")
+	}
+}
+
+func (r *renderer) doctype(n *h.Node) error {
+	if _, err := r.w.WriteString("<!DOCTYPE "); err != nil {
+		return err
+	}
+	if err := escape(r.w, n.Data); err != nil {
+		return err
+	}
+	var p, s string
+	for _, attr := range n.Attr {
+		switch attr.Key {
+		case "public":
+			p = attr.Val
+		case "system":
+			s = attr.Val
+		}
+	}
+	if p != "" {
+		if _, err := r.w.WriteString(" PUBLIC "); err != nil {
+			return err
+		}
+		if err := writeQuoted(r.w, p); err != nil {
+			return err
+		}
+		if s != "" {
+			if err := r.w.WriteByte(' '); err != nil {
+				return err
+			}
+			if err := writeQuoted(r.w, s); err != nil {
+				return err
+			}
+		}
+	} else if s != "" {
+		if _, err := r.w.WriteString(" SYSTEM "); err != nil {
+			return err
+		}
+		if err := writeQuoted(r.w, s); err != nil {
+			return err
+		}
+	}
+	return r.w.WriteByte('>')
+}
+
+func (r *renderer) element(n *h.Node, depth int) error {
+	n, err := r.annotate(n)
+	if err != nil {
+		return err
+	}
+	if err := r.w.WriteByte('<'); err != nil {
+		return err
+	}
+	if _, err := r.w.WriteString(n.Data); err != nil {
+		return err
+	}
+	if r.xml {
+		saved := r.scope
+		defer func() { r.scope = saved }()
+		if err := r.xmlAttrs(n); err != nil {
+			return err
+		}
+	} else {
+		for _, attr := range n.Attr {
+			var err error
+			if r.minify {
+				err = r.minifyAttr(attr)
+			} else {
+				err = r.attr(attr)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if voidElements[n.Data] {
+		if n.FirstChild != nil {
+			return fmt.Errorf("ht: void element <%s> has child nodes", n.Data)
+		}
+		if r.minify {
+			return r.w.WriteByte('>')
+		}
+		_, err := r.w.WriteString("/>")
+		return err
+	}
+	if err := r.w.WriteByte('>'); err != nil {
+		return err
+	}
+
+	// Add initial newline where there is danger of a newline being ignored.
+	if c := n.FirstChild; c != nil && c.Type == h.TextNode && strings.HasPrefix(c.Data, "\n") && !r.xml {
+		switch n.Data {
+		case "pre", "listing", "textarea":
+			if err := r.w.WriteByte('\n'); err != nil {
+				return err
+			}
+		}
+	}
+
+	if preformatted(n) {
+		r.preserve++
+		defer func() { r.preserve-- }()
+	}
+
+	if r.xml && literalText(n) {
+		if err := r.xmlLiteral(n, depth); err != nil {
+			return err
+		}
+	} else if literalText(n) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			var err error
+			if c.Type == h.TextNode && len(r.visitors) == 0 {
+				_, err = r.w.WriteString(c.Data)
+			} else if c.Type == h.TextNode {
+				if v := r.visit(c); v != nil && v.Type == h.TextNode {
+					_, err = r.w.WriteString(v.Data)
+				} else if v != nil {
+					err = r.emit(v, depth+1)
+				}
+			} else {
+				err = r.node(c, depth+1)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if n.Data == "plaintext" {
+			return errPlaintext
+		}
+	} else {
+		block := r.pretty && !preformatted(n) && blockContent(n)
+		if err := r.children(n, depth+1, block); err != nil {
+			return err
+		}
+		if block && n.FirstChild != nil {
+			if err := r.line(depth); err != nil {
+				return err
+			}
+		}
+	}
+
+	if r.minify && omitEndTag(n) {
+		return nil
+	}
+	if _, err := r.w.WriteString("</"); err != nil {
+		return err
+	}
+	if _, err := r.w.WriteString(n.Data); err != nil {
+		return err
+	}
+	return r.w.WriteByte('>')
+}
+
+func (r *renderer) attr(attr h.Attribute) error {
+	if err := r.w.WriteByte(' '); err != nil {
+		return err
+	}
+	if attr.Namespace != "" {
+		if _, err := r.w.WriteString(attr.Namespace); err != nil {
+			return err
+		}
+		if err := r.w.WriteByte(':'); err != nil {
+			return err
+		}
+	}
+	if _, err := r.w.WriteString(attr.Key); err != nil {
+		return err
+	}
+	if _, err := r.w.WriteString(`="`); err != nil {
+		return err
+	}
+	if err := escape(r.w, attr.Val); err != nil {
+		return err
+	}
+	return r.w.WriteByte('"')
+}
+
+// children renders the children of n. When block is set, each child is placed
+// on its own line at the given depth. Fragments are transparent: their
+// children are laid out as if they belonged to n.
+func (r *renderer) children(n *h.Node, depth int, block bool) error {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if block && c.Type == h.DocumentNode {
+			if err := r.children(c, depth, true); err != nil {
+				return err
+			}
+			continue
+		}
+		if block && c.Type != flushNode {
+			if err := r.line(depth); err != nil {
+				return err
+			}
+		}
+		if err := r.node(c, depth); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// line starts a new line indented to depth, unless nothing has been written.
+func (r *renderer) line(depth int) error {
+	if r.fresh {
+		return nil
+	}
+	if _, err := r.w.WriteString(r.newline); err != nil {
+		return err
+	}
+	for range depth {
+		if _, err := r.w.WriteString(r.indent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// blockContent reports whether every child of n, looking through fragments,
+// can be moved onto its own line without changing the meaning of the page.
+func blockContent(n *h.Node) bool {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch c.Type {
+		case h.ElementNode:
+			if inlineElement(c) {
+				return false
+			}
+		case h.CommentNode, h.DoctypeNode, flushNode, lazyNode, deferredNode, tryNode:
+		case h.DocumentNode:
+			if !blockContent(c) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// inlineElement reports whether whitespace next to n can show on the page:
+// n is visible phrasing content, such as <b> or <img>, or a custom element.
+func inlineElement(n *h.Node) bool {
+	if n.Namespace != "" {
+		return false
+	}
+	if n.DataAtom == 0 {
+		return strings.Contains(n.Data, "-")
+	}
+	c := Categories(n.DataAtom)
+	return c.Has(PhrasingContent) && !c.Has(MetadataContent)
+}
+
+// preformatted reports whether whitespace inside n is significant.
+func preformatted(n *h.Node) bool {
+	if n.Namespace != "" {
+		return false
+	}
+	switch n.Data {
+	case "pre", "listing", "textarea":
+		return true
+	}
+	return false
+}
+
+// literalText reports whether text children of n are written without
+// escaping, per WHATWG HTML 13.3 (and noscript, as html.Render does).
+func literalText(n *h.Node) bool {
+	if n.Namespace != "" {
+		return false
+	}
+	switch n.Data {
+	case "iframe", "noembed", "noframes", "noscript", "plaintext", "script", "style", "xmp":
+		return true
+	}
+	return false
+}
+
+// writeQuoted writes s surrounded by double quotes, or single quotes if s
+// contains a double quote. It is used for doctype identifiers.
+func writeQuoted(w writer, s string) error {
+	var q byte = '"'
+	if strings.Contains(s, `"`) {
+		q = '\''
+	}
+	if err := w.WriteByte(q); err != nil {
+		return err
+	}
+	if _, err := w.WriteString(s); err != nil {
+		return err
+	}
+	return w.WriteByte(q)
+}
+
+// voidElements are the elements that can't have any contents.
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"keygen": true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+const escapedChars = "&'<>\"\r"
+
+// escape writes s with the same escaping html.Render applies to text and
+// attribute values.
+func escape(w writer, s string) error {
+	i := strings.IndexAny(s, escapedChars)
+	for i != -1 {
+		if _, err := w.WriteString(s[:i]); err != nil {
+			return err
+		}
+		var esc string
+		switch s[i] {
+		case '&':
+			esc = "&amp;"
+		case '\'':
+			esc = "&#39;"
+		case '<':
+			esc = "&lt;"
+		case '>':
+			esc = "&gt;"
+		case '"':
+			esc = "&#34;"
+		case '\r':
+			esc = "&#13;"
+		}
+		s = s[i+1:]
+		if _, err := w.WriteString(esc); err != nil {
+			return err
+		}
+		i = strings.IndexAny(s, escapedChars)
+	}
+	_, err := w.WriteString(s)
+	return err
+}
+
+// escapeComment escapes every '&', and every '>' that is at the start of the
+// comment or follows a '!' or '-', matching html.Render.
+func escapeComment(w writer, s string) error {
+	i := 0
+	for j := 0; j < len(s); j++ {
+		var esc string
+		switch s[j] {
+		case '&':
+			esc = "&amp;"
+		case '>':
+			if j > 0 {
+				if prev := s[j-1]; prev != '!' && prev != '-' {
+					continue
+				}
+			}
+			esc = "&gt;"
+		default:
+			continue
+		}
+		if _, err := w.WriteString(s[i:j]); err != nil {
+			return err
+		}
+		if _, err := w.WriteString(esc); err != nil {
+			return err
+		}
+		i = j + 1
+	}
+	_, err := w.WriteString(s[i:])
+	return err
+}
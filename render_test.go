@@ -0,0 +1,96 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func testPage() *html.Node {
+	return Document(
+		Doctype("html"),
+		Html(
+			Lang("en"),
+			Head(
+				Meta(Charset("utf-8")),
+				Title(Text("A <page> & 'more'")),
+				Script(Raw("if (a < b) { run() }")),
+				Style(Text("p > b { color: red }")),
+			),
+			Body(
+				Class("a", "b"),
+				Comment("-> comment & more"),
+				P(Text("Hello, "), B(Text("World")), Text("\r\n")),
+				Ul(Fragment(Li(Text("one")), Li(Text("two")))),
+				Pre(Text("\nindented\n  text")),
+				Textarea(Text("\nvalue")),
+				Input(Type("checkbox"), Checked(), Value(`"quoted"`)),
+				Br(),
+				Raw("<span>raw</span>"),
+			),
+		),
+	)
+}
+
+func TestRenderMatchesHTMLRender(t *testing.T) {
+	var want, got bytes.Buffer
+	if err := html.Render(&want, testPage()); err != nil {
+		t.Fatal(err)
+	}
+	if err := Render(context.Background(), &got, testPage()); err != nil {
+		t.Fatal(err)
+	}
+	if stripSources(got.String()) != want.String() {
+		t.Errorf("Render output differs from html.Render\ngot:  %s\nwant: %s", stripSources(got.String()), want.String())
+	}
+}
+
+func TestRenderIndent(t *testing.T) {
+	node := Div(Ul(Li(Text("one")), Li(Text("two <b>"))), P(Text("a "), B(Text("b"))))
+	want := "<div>\r\n\t<ul>\r\n\t\t<li>one</li>\r\n\t\t<li>two &lt;b&gt;</li>\r\n\t</ul>\r\n\t<p>a <b>b</b></p>\r\n</div>"
+
+	var got bytes.Buffer
+	if err := Render(context.Background(), &got, node, WithIndent("\t"), WithNewline("\r\n")); err != nil {
+		t.Fatal(err)
+	}
+	if stripSources(got.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(got.String()), want)
+	}
+}
+
+func TestRenderIndentPhrasing(t *testing.T) {
+	for _, tt := range []struct {
+		node *html.Node
+		want string
+	}{
+		{P(B(Text("a")), I(Text("b"))), "<p><b>a</b><i>b</i></p>"},
+		{Div(A(Href("/"), Img(Src("/logo.png"))), Span(Text("x"))), `<div><a href="/"><img src="/logo.png"/></a><span>x</span></div>`},
+		{Div(Span(), &html.Node{Type: html.ElementNode, Data: "my-icon"}), "<div><span></span><my-icon></my-icon></div>"},
+		{Head(Meta(Charset("utf-8")), Script(Src("/a.js"))), "<head>\n  <meta charset=\"utf-8\"/>\n  <script src=\"/a.js\"></script>\n</head>"},
+	} {
+		var got bytes.Buffer
+		if err := Render(context.Background(), &got, tt.node, WithIndent("  ")); err != nil {
+			t.Fatal(err)
+		}
+		if stripSources(got.String()) != tt.want {
+			t.Errorf("got %q, want %q", stripSources(got.String()), tt.want)
+		}
+	}
+}
+
+func TestRenderCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var buf bytes.Buffer
+	err := Render(ctx, &buf, testPage())
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want context.Canceled", err)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %q after cancellation", stripSources(buf.String()))
+	}
+}
@@ -0,0 +1,64 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	h "golang.org/x/net/html"
+)
+
+// StaticNode is a subtree that never changes, rendered to markup once and
+// reused from then on. Create one with Static, typically in a package-level
+// variable, and place it in a tree with Node. It is safe for concurrent use.
+type StaticNode struct {
+	build func() *h.Node
+
+	mu   sync.RWMutex
+	html string
+	ok   bool
+}
+
+// Static returns a StaticNode for the subtree returned by build. Navbars,
+// footers and other chrome that is identical on every request only pay the
+// cost of building and serializing their nodes the first time.
+//
+// build must not return lazy, deferred or flush nodes, since the output is
+// captured outside of any request.
+func Static(build func() *h.Node) *StaticNode {
+	return &StaticNode{build: build}
+}
+
+// Node returns a RawNode holding the rendered subtree, rendering it first if
+// this is the first use since creation or the last Invalidate. A subtree that
+// fails to render is reported to OnError, returned as an empty Fragment and
+// retried on next use.
+func (s *StaticNode) Node() *h.Node {
+	s.mu.RLock()
+	data, ok := s.html, s.ok
+	s.mu.RUnlock()
+	if ok {
+		return Raw(data)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.ok {
+		var b strings.Builder
+		if err := Render(context.Background(), &b, s.build()); err != nil {
+			fail(context.Background(), err, nil)
+			return Fragment()
+		}
+		s.html, s.ok = b.String(), true
+	}
+	return Raw(s.html)
+}
+
+// Invalidate discards the rendered markup so that the next use rebuilds it.
+// It is meant for development, for example after reloading templates or
+// translations.
+func (s *StaticNode) Invalidate() {
+	s.mu.Lock()
+	s.html, s.ok = "", false
+	s.mu.Unlock()
+}
@@ -0,0 +1,40 @@
+package ht
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestStatic(t *testing.T) {
+	var reported error
+	OnError = func(ctx context.Context, err error) { reported = err }
+	defer func() { OnError = nil }()
+
+	broken := true
+	nav := Static(func() *html.Node {
+		if broken {
+			return Br(Text("not void"))
+		}
+		return Nav(Text("home"))
+	})
+
+	var b strings.Builder
+	if err := Render(context.Background(), &b, Div(nav.Node())); err != nil {
+		t.Fatal(err)
+	}
+	if stripSources(b.String()) != "<div></div>" || reported == nil {
+		t.Errorf("got %q and reported %v, want nothing rendered and the error reported", stripSources(b.String()), reported)
+	}
+
+	broken = false
+	b.Reset()
+	if err := Render(context.Background(), &b, Div(nav.Node())); err != nil {
+		t.Fatal(err)
+	}
+	if want := "<div><nav>home</nav></div>"; stripSources(b.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(b.String()), want)
+	}
+}
@@ -0,0 +1,53 @@
+package ht
+
+import (
+	"context"
+	"net/http"
+
+	h "golang.org/x/net/html"
+)
+
+// Flush returns a marker node that can go anywhere in the tree. When Render
+// reaches it, everything written so far is sent to the client by calling
+// http.Flusher.Flush on the writer, and rendering carries on. Placed right
+// after Head, it lets the browser start fetching stylesheets and scripts
+// while the body is still being built.
+//
+// The marker writes nothing itself and is ignored by writers that cannot
+// flush.
+func Flush() *h.Node {
+	return dynamicNode(flushNode, "ht:flush", nil)
+}
+
+// Lazy returns a node whose content is only built when Render reaches it,
+// using the render context. Combined with Flush, the expensive parts of a page
+// can be built after its head has already been sent. A nil result renders
+// nothing.
+func Lazy(build func(ctx context.Context) *h.Node) *h.Node {
+	return dynamicNode(lazyNode, "ht:lazy", build)
+}
+
+// flush sends everything written so far to the client.
+func (r *renderer) flush() error {
+	if r.buf != nil {
+		if err := r.buf.Flush(); err != nil {
+			return err
+		}
+	}
+	if f, ok := r.out.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+// lazy builds and renders the content of a Lazy node.
+func (r *renderer) lazy(n *h.Node, depth int) error {
+	build, _ := payload(n).(func(context.Context) *h.Node)
+	if build == nil {
+		return nil
+	}
+	if c := build(r.ctx); c != nil {
+		return r.node(c, depth)
+	}
+	return nil
+}
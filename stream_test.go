@@ -0,0 +1,33 @@
+package ht
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestRenderFlushAndLazy(t *testing.T) {
+	rec := httptest.NewRecorder()
+	var flushedHead string
+
+	page := Html(
+		Head(Title(Text("Streaming"))),
+		Flush(),
+		Body(Lazy(func(ctx context.Context) *html.Node {
+			flushedHead = stripSources(rec.Body.String())
+			return P(Text("built late"))
+		})),
+	)
+	if err := Render(context.Background(), rec, page); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "<html><head><title>Streaming</title></head>"; flushedHead != want || !rec.Flushed {
+		t.Errorf("flushed %q before building the body (Flushed=%v), want %q", flushedHead, rec.Flushed, want)
+	}
+	if want := "<html><head><title>Streaming</title></head><body><p>built late</p></body></html>"; stripSources(rec.Body.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(rec.Body.String()), want)
+	}
+}
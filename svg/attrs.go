@@ -0,0 +1,118 @@
+package svg
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/accentdesign/ht"
+	h "golang.org/x/net/html"
+)
+
+// num formats a number the shortest way that round-trips.
+func num(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+
+// nums formats a list of numbers separated by spaces.
+func nums(vs ...float64) string {
+	s := make([]string, len(vs))
+	for i, v := range vs {
+		s[i] = num(v)
+	}
+	return strings.Join(s, " ")
+}
+
+// Xmlns declares the SVG namespace, for standalone documents. Inline SVG in
+// HTML does not need it, and ht.WithXML adds it where required.
+func Xmlns() h.Attribute { return ht.Attr("xmlns", "http://www.w3.org/2000/svg") }
+
+// XlinkHref is the SVG 1.1 form of Href, for older renderers. It is written
+// as xlink:href, in the namespace html.Parse gives it.
+func XlinkHref(v string) h.Attribute { return h.Attribute{Namespace: "xlink", Key: "href", Val: v} }
+
+// ViewBox sets the user coordinate system, e.g. ViewBox(0, 0, 24, 24).
+func ViewBox(minX, minY, width, height float64) h.Attribute {
+	return ht.Attr("viewBox", nums(minX, minY, width, height))
+}
+
+// Points sets the vertices of a Polyline or Polygon from x, y pairs.
+func Points(xy ...float64) h.Attribute {
+	s := make([]string, 0, len(xy)/2)
+	for i := 0; i+1 < len(xy); i += 2 {
+		s = append(s, num(xy[i])+","+num(xy[i+1]))
+	}
+	return ht.Attr("points", strings.Join(s, " "))
+}
+
+// geometry
+
+func X(v float64) h.Attribute                  { return ht.Attr("x", num(v)) }
+func Y(v float64) h.Attribute                  { return ht.Attr("y", num(v)) }
+func X1(v float64) h.Attribute                 { return ht.Attr("x1", num(v)) }
+func Y1(v float64) h.Attribute                 { return ht.Attr("y1", num(v)) }
+func X2(v float64) h.Attribute                 { return ht.Attr("x2", num(v)) }
+func Y2(v float64) h.Attribute                 { return ht.Attr("y2", num(v)) }
+func Cx(v float64) h.Attribute                 { return ht.Attr("cx", num(v)) }
+func Cy(v float64) h.Attribute                 { return ht.Attr("cy", num(v)) }
+func R(v float64) h.Attribute                  { return ht.Attr("r", num(v)) }
+func Rx(v float64) h.Attribute                 { return ht.Attr("rx", num(v)) }
+func Ry(v float64) h.Attribute                 { return ht.Attr("ry", num(v)) }
+func Dx(v float64) h.Attribute                 { return ht.Attr("dx", num(v)) }
+func Dy(v float64) h.Attribute                 { return ht.Attr("dy", num(v)) }
+func Width(v float64) h.Attribute              { return ht.Attr("width", num(v)) }
+func Height(v float64) h.Attribute             { return ht.Attr("height", num(v)) }
+func D(v string) h.Attribute                   { return ht.Attr("d", v) }
+func PathLength(v float64) h.Attribute         { return ht.Attr("pathLength", num(v)) }
+func Transform(v string) h.Attribute           { return ht.Attr("transform", v) }
+func PreserveAspectRatio(v string) h.Attribute { return ht.Attr("preserveAspectRatio", v) }
+func Href(v string) h.Attribute                { return ht.Attr("href", v) }
+
+// presentation
+
+func Fill(v string) h.Attribute                { return ht.Attr("fill", v) }
+func FillOpacity(v float64) h.Attribute        { return ht.Attr("fill-opacity", num(v)) }
+func FillRule(v string) h.Attribute            { return ht.Attr("fill-rule", v) }
+func Stroke(v string) h.Attribute              { return ht.Attr("stroke", v) }
+func StrokeWidth(v float64) h.Attribute        { return ht.Attr("stroke-width", num(v)) }
+func StrokeOpacity(v float64) h.Attribute      { return ht.Attr("stroke-opacity", num(v)) }
+func StrokeLinecap(v string) h.Attribute       { return ht.Attr("stroke-linecap", v) }
+func StrokeLinejoin(v string) h.Attribute      { return ht.Attr("stroke-linejoin", v) }
+func StrokeDasharray(v ...float64) h.Attribute { return ht.Attr("stroke-dasharray", nums(v...)) }
+func StrokeDashoffset(v float64) h.Attribute   { return ht.Attr("stroke-dashoffset", num(v)) }
+func Opacity(v float64) h.Attribute            { return ht.Attr("opacity", num(v)) }
+func StopColor(v string) h.Attribute           { return ht.Attr("stop-color", v) }
+func StopOpacity(v float64) h.Attribute        { return ht.Attr("stop-opacity", num(v)) }
+func Offset(v string) h.Attribute              { return ht.Attr("offset", v) }
+func ClipPathAttr(v string) h.Attribute        { return ht.Attr("clip-path", v) }
+func MaskAttr(v string) h.Attribute            { return ht.Attr("mask", v) }
+func FilterAttr(v string) h.Attribute          { return ht.Attr("filter", v) }
+func MarkerStart(v string) h.Attribute         { return ht.Attr("marker-start", v) }
+func MarkerEnd(v string) h.Attribute           { return ht.Attr("marker-end", v) }
+func TextAnchor(v string) h.Attribute          { return ht.Attr("text-anchor", v) }
+func DominantBaseline(v string) h.Attribute    { return ht.Attr("dominant-baseline", v) }
+func FontSize(v string) h.Attribute            { return ht.Attr("font-size", v) }
+func FontFamily(v string) h.Attribute          { return ht.Attr("font-family", v) }
+
+// gradients, patterns and markers
+
+func GradientUnits(v string) h.Attribute     { return ht.Attr("gradientUnits", v) }
+func GradientTransform(v string) h.Attribute { return ht.Attr("gradientTransform", v) }
+func SpreadMethod(v string) h.Attribute      { return ht.Attr("spreadMethod", v) }
+func PatternUnits(v string) h.Attribute      { return ht.Attr("patternUnits", v) }
+func ClipPathUnits(v string) h.Attribute     { return ht.Attr("clipPathUnits", v) }
+func MarkerWidth(v float64) h.Attribute      { return ht.Attr("markerWidth", num(v)) }
+func MarkerHeight(v float64) h.Attribute     { return ht.Attr("markerHeight", num(v)) }
+func RefX(v float64) h.Attribute             { return ht.Attr("refX", num(v)) }
+func RefY(v float64) h.Attribute             { return ht.Attr("refY", num(v)) }
+func Orient(v string) h.Attribute            { return ht.Attr("orient", v) }
+
+// filters and animation
+
+func In(v string) h.Attribute            { return ht.Attr("in", v) }
+func In2(v string) h.Attribute           { return ht.Attr("in2", v) }
+func Result(v string) h.Attribute        { return ht.Attr("result", v) }
+func StdDeviation(v float64) h.Attribute { return ht.Attr("stdDeviation", num(v)) }
+func AttributeName(v string) h.Attribute { return ht.Attr("attributeName", v) }
+func Dur(v string) h.Attribute           { return ht.Attr("dur", v) }
+func RepeatCount(v string) h.Attribute   { return ht.Attr("repeatCount", v) }
+func From(v string) h.Attribute          { return ht.Attr("from", v) }
+func To(v string) h.Attribute            { return ht.Attr("to", v) }
+func Values(v string) h.Attribute        { return ht.Attr("values", v) }
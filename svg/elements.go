@@ -0,0 +1,84 @@
+// Package svg provides constructors for inline SVG. Elements are created in
+// the "svg" namespace with the camelCase names html.Parse uses, so rendered
+// markup parses back into the same tree. Arguments follow the same rules as
+// ht.Element:
+//
+//	svg.Svg(svg.ViewBox(0, 0, 24, 24), svg.Width(24), svg.Height(24),
+//		svg.Path(svg.D("M4 12h16"), svg.Stroke("currentColor"), svg.StrokeWidth(2)),
+//	)
+package svg
+
+import (
+	"github.com/accentdesign/ht"
+	h "golang.org/x/net/html"
+)
+
+// Element constructs an SVG element with the given name, for elements this
+// package has no constructor for.
+func Element(name string, args ...any) *h.Node { return ht.ElementNS("svg", name, args...) }
+
+// structure
+
+func Svg(args ...any) *h.Node      { return Element("svg", args...) }
+func G(args ...any) *h.Node        { return Element("g", args...) }
+func Defs(args ...any) *h.Node     { return Element("defs", args...) }
+func Symbol(args ...any) *h.Node   { return Element("symbol", args...) }
+func Use(args ...any) *h.Node      { return Element("use", args...) }
+func A(args ...any) *h.Node        { return Element("a", args...) }
+func Switch(args ...any) *h.Node   { return Element("switch", args...) }
+func View(args ...any) *h.Node     { return Element("view", args...) }
+func Title(args ...any) *h.Node    { return Element("title", args...) }
+func Desc(args ...any) *h.Node     { return Element("desc", args...) }
+func Metadata(args ...any) *h.Node { return Element("metadata", args...) }
+func Style(args ...any) *h.Node    { return Element("style", args...) }
+func Script(args ...any) *h.Node   { return Element("script", args...) }
+
+// ForeignObject embeds other content, usually HTML elements from package ht.
+func ForeignObject(args ...any) *h.Node { return Element("foreignObject", args...) }
+
+// shapes
+
+func Path(args ...any) *h.Node     { return Element("path", args...) }
+func Circle(args ...any) *h.Node   { return Element("circle", args...) }
+func Ellipse(args ...any) *h.Node  { return Element("ellipse", args...) }
+func Line(args ...any) *h.Node     { return Element("line", args...) }
+func Polyline(args ...any) *h.Node { return Element("polyline", args...) }
+func Polygon(args ...any) *h.Node  { return Element("polygon", args...) }
+func Rect(args ...any) *h.Node     { return Element("rect", args...) }
+func Image(args ...any) *h.Node    { return Element("image", args...) }
+
+// text
+
+func Text(args ...any) *h.Node     { return Element("text", args...) }
+func Tspan(args ...any) *h.Node    { return Element("tspan", args...) }
+func TextPath(args ...any) *h.Node { return Element("textPath", args...) }
+
+// paint servers, clipping and markers
+
+func LinearGradient(args ...any) *h.Node { return Element("linearGradient", args...) }
+func RadialGradient(args ...any) *h.Node { return Element("radialGradient", args...) }
+func Stop(args ...any) *h.Node           { return Element("stop", args...) }
+func Pattern(args ...any) *h.Node        { return Element("pattern", args...) }
+func ClipPath(args ...any) *h.Node       { return Element("clipPath", args...) }
+func Mask(args ...any) *h.Node           { return Element("mask", args...) }
+func Marker(args ...any) *h.Node         { return Element("marker", args...) }
+
+// filters
+
+func Filter(args ...any) *h.Node         { return Element("filter", args...) }
+func FeBlend(args ...any) *h.Node        { return Element("feBlend", args...) }
+func FeColorMatrix(args ...any) *h.Node  { return Element("feColorMatrix", args...) }
+func FeComposite(args ...any) *h.Node    { return Element("feComposite", args...) }
+func FeDropShadow(args ...any) *h.Node   { return Element("feDropShadow", args...) }
+func FeFlood(args ...any) *h.Node        { return Element("feFlood", args...) }
+func FeGaussianBlur(args ...any) *h.Node { return Element("feGaussianBlur", args...) }
+func FeMerge(args ...any) *h.Node        { return Element("feMerge", args...) }
+func FeMergeNode(args ...any) *h.Node    { return Element("feMergeNode", args...) }
+func FeOffset(args ...any) *h.Node       { return Element("feOffset", args...) }
+
+// animation
+
+func Animate(args ...any) *h.Node          { return Element("animate", args...) }
+func AnimateMotion(args ...any) *h.Node    { return Element("animateMotion", args...) }
+func AnimateTransform(args ...any) *h.Node { return Element("animateTransform", args...) }
+func Set(args ...any) *h.Node              { return Element("set", args...) }
@@ -0,0 +1,43 @@
+package svg
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/accentdesign/ht"
+	"github.com/accentdesign/ht/internal/htmltest"
+	"golang.org/x/net/html"
+)
+
+func TestRoundTrip(t *testing.T) {
+	icon := Svg(ViewBox(0, 0, 24, 24), Width(24), Height(24), ht.Class("icon"),
+		Defs(
+			LinearGradient(ht.Id("g"), GradientUnits("userSpaceOnUse"), X1(0), Y1(0), X2(24), Y2(24),
+				Stop(Offset("0%"), StopColor("#fff")),
+				Stop(Offset("100%"), StopColor("#000"), StopOpacity(0.5)),
+			),
+			ClipPath(ht.Id("c"), Circle(Cx(12), Cy(12), R(10))),
+			Filter(ht.Id("f"), FeGaussianBlur(In("SourceGraphic"), StdDeviation(1.5))),
+		),
+		G(ClipPathAttr("url(#c)"), Transform("rotate(45 12 12)"),
+			Path(D("M4 12h16"), Stroke("url(#g)"), StrokeWidth(2), StrokeLinecap("round")),
+			Polygon(Points(1, 2, 3, 4, 5, 6), Fill("none"), StrokeDasharray(2, 1)),
+		),
+		Use(XlinkHref("#g")),
+		ForeignObject(Width(10), Height(10), ht.Div(ht.Text("html"))),
+		Text(X(12), Y(20), TextAnchor("middle"), Tspan("a < b")),
+	)
+
+	var b strings.Builder
+	if err := ht.Render(context.Background(), &b, ht.Div(icon)); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := html.Parse(strings.NewReader(b.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := htmltest.Compare(icon, htmltest.Find(doc, "svg", "svg")); err != nil {
+		t.Errorf("%v\nrendered: %s", err, b.String())
+	}
+}
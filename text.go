@@ -0,0 +1,460 @@
+package ht
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	h "golang.org/x/net/html"
+	a "golang.org/x/net/html/atom"
+)
+
+// TextOption configures a call to RenderText.
+type TextOption func(*textRenderer)
+
+// WithWidth wraps paragraphs at width columns. The default is 78; zero turns
+// wrapping off. Preformatted text and tables are never wrapped.
+func WithWidth(width int) TextOption {
+	return func(t *textRenderer) {
+		t.width = width
+	}
+}
+
+// RenderText writes node as readable plain text, such as the text/plain part
+// of an email built with ht:
+//
+//   - paragraphs and other blocks are separated by blank lines, and wrapped;
+//   - H1 and H2 are underlined, other headings stand on their own line;
+//   - links become "text (url)";
+//   - Ul items get "* " bullets and Ol items are numbered;
+//   - tables are laid out in aligned columns;
+//   - Pre keeps its text as it is, Br starts a new line, Hr draws a rule;
+//   - Head, Script, Style, Template and comments are dropped.
+//
+// Lazy, Deferred and TryLazy content is built with ctx, in place and in
+// order. Rendering stops with ctx's error once it is cancelled, and with a
+// *PanicError if a Lazy or Deferred builder panics; nothing is written then.
+func RenderText(ctx context.Context, w io.Writer, node *h.Node, opts ...TextOption) error {
+	t := &textRenderer{ctx: ctx, width: 78}
+	for _, opt := range opts {
+		opt(t)
+	}
+	lines := t.flow(node, t.width, false)
+	if t.err != nil {
+		return t.err
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	_, err := io.WriteString(w, strings.Join(lines, "\n")+"\n")
+	return err
+}
+
+// textRenderer holds the settings of a single RenderText call.
+type textRenderer struct {
+	ctx   context.Context
+	width int
+	err   error // the first error from building dynamic content
+}
+
+// flow lays out the content of n as lines no wider than width. Blocks are
+// separated by a blank line, unless tight is set.
+func (t *textRenderer) flow(n *h.Node, width int, tight bool) []string {
+	var (
+		chunks  [][]string
+		inl     inline
+		flushes int // times inl has been flushed, to tell when it was reset
+	)
+	flush := func() {
+		if lines := inl.wrap(width); len(lines) > 0 {
+			chunks = append(chunks, lines)
+		}
+		inl = inline{}
+		flushes++
+	}
+	var walk func(n *h.Node)
+	walk = func(n *h.Node) {
+		for c := range t.children(n) {
+			switch {
+			case c.Type == h.TextNode:
+				inl.text(c.Data)
+			case c.Type == h.RawNode:
+				walk(parseRaw(c.Data))
+			case c.Type != h.ElementNode || skipText[c.DataAtom]:
+			case c.DataAtom == a.Br:
+				inl.newline()
+			case c.DataAtom == a.Img:
+				alt, _ := lookupAttr(c, "", "alt")
+				inl.text(alt)
+			case c.DataAtom == a.A:
+				start, before := inl.buf.Len(), flushes
+				walk(c)
+				if flushes != before {
+					// A block inside the link flushed what came before it.
+					start = 0
+				}
+				label := strings.TrimSpace(inl.buf.String()[start:])
+				href, _ := lookupAttr(c, "", "href")
+				if href != "" && href != label && !strings.HasPrefix(href, "#") && !strings.HasPrefix(href, "javascript:") {
+					if label != "" {
+						inl.text(" ")
+					}
+					inl.text("(" + strings.TrimPrefix(href, "mailto:") + ")")
+				}
+			case textBlocks[c.DataAtom]:
+				flush()
+				if lines := t.block(c, width); len(lines) > 0 {
+					chunks = append(chunks, lines)
+				}
+			default:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+	flush()
+
+	var lines []string
+	for i, chunk := range chunks {
+		if i > 0 && !tight {
+			lines = append(lines, "")
+		}
+		lines = append(lines, chunk...)
+	}
+	return lines
+}
+
+// block lays out a block-level element.
+func (t *textRenderer) block(n *h.Node, width int) []string {
+	switch n.DataAtom {
+	case a.H1, a.H2:
+		lines := t.flow(n, width, true)
+		if len(lines) == 0 {
+			return nil
+		}
+		rule := "="
+		if n.DataAtom == a.H2 {
+			rule = "-"
+		}
+		longest := 0
+		for _, line := range lines {
+			longest = max(longest, utf8.RuneCountInString(line))
+		}
+		return append(lines, strings.Repeat(rule, longest))
+	case a.Ul, a.Ol:
+		return t.list(n, width)
+	case a.Dl:
+		var lines []string
+		for c := range t.children(n) {
+			switch c.DataAtom {
+			case a.Dt:
+				lines = append(lines, t.flow(c, width, true)...)
+			case a.Dd:
+				lines = append(lines, indent(t.flow(c, width-4, true), "    ", "    ")...)
+			}
+		}
+		return lines
+	case a.Table:
+		return t.table(n)
+	case a.Pre:
+		return strings.Split(strings.TrimPrefix(strings.TrimRight(t.textContent(n), "\n"), "\n"), "\n")
+	case a.Blockquote:
+		return indent(t.flow(n, width-2, false), "> ", "> ")
+	case a.Hr:
+		return []string{strings.Repeat("-", orDefault(width, 40))}
+	}
+	return t.flow(n, width, false)
+}
+
+// list lays out the items of a Ul or Ol with bullets or numbers.
+func (t *textRenderer) list(n *h.Node, width int) []string {
+	var lines []string
+	num := 1
+	if start, ok := lookupAttr(n, "", "start"); ok {
+		if i, err := strconv.Atoi(start); err == nil {
+			num = i
+		}
+	}
+	for c := range t.children(n) {
+		if c.DataAtom != a.Li {
+			continue
+		}
+		marker := "* "
+		if n.DataAtom == a.Ol {
+			marker = strconv.Itoa(num) + ". "
+			num++
+		}
+		pad := strings.Repeat(" ", len(marker))
+		lines = append(lines, indent(t.flow(c, width-len(marker), true), marker, pad)...)
+	}
+	return lines
+}
+
+// table lays out the rows of a table in columns separated by two spaces,
+// with a rule under a header row.
+func (t *textRenderer) table(n *h.Node) []string {
+	var rows [][]string
+	var header []bool
+	var collect func(n *h.Node)
+	collect = func(n *h.Node) {
+		for c := range t.children(n) {
+			switch c.DataAtom {
+			case a.Thead, a.Tbody, a.Tfoot:
+				collect(c)
+			case a.Tr:
+				var row []string
+				allTh := true
+				for cell := range t.children(c) {
+					if cell.DataAtom != a.Td && cell.DataAtom != a.Th {
+						continue
+					}
+					allTh = allTh && cell.DataAtom == a.Th
+					var inl inline
+					inl.text(t.textContent(cell))
+					row = append(row, strings.TrimSpace(inl.buf.String()))
+				}
+				rows = append(rows, row)
+				header = append(header, allTh && len(row) > 0)
+			}
+		}
+	}
+	collect(n)
+
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	var lines []string
+	for r, row := range rows {
+		var b strings.Builder
+		for i, cell := range row {
+			if i > 0 {
+				b.WriteString("  ")
+			}
+			b.WriteString(cell)
+			b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell)))
+		}
+		lines = append(lines, strings.TrimRight(b.String(), " "))
+		if header[r] {
+			var rule []string
+			for i := range row {
+				rule = append(rule, strings.Repeat("-", widths[i]))
+			}
+			lines = append(lines, strings.Join(rule, "  "))
+		}
+	}
+	return lines
+}
+
+// inline accumulates inline text with collapsed whitespace.
+type inline struct {
+	buf   strings.Builder
+	space bool // a space is pending before the next word
+	bol   bool // the last thing written was a line break
+}
+
+func (in *inline) text(s string) {
+	if s == "" {
+		return
+	}
+	if isASCIISpace(rune(s[0])) {
+		in.space = true
+	}
+	for i, f := range strings.FieldsFunc(s, isASCIISpace) {
+		if (i > 0 || in.space) && in.buf.Len() > 0 && !in.bol {
+			in.buf.WriteByte(' ')
+		}
+		in.buf.WriteString(f)
+		in.space, in.bol = false, false
+	}
+	if isASCIISpace(rune(s[len(s)-1])) {
+		in.space = true
+	}
+}
+
+func (in *inline) newline() {
+	in.buf.WriteByte('\n')
+	in.space, in.bol = false, true
+}
+
+// wrap splits the accumulated text into lines no wider than width, breaking
+// between words. Words longer than width are left whole.
+func (in *inline) wrap(width int) []string {
+	s := strings.TrimRight(in.buf.String(), "\n")
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	var lines []string
+	for _, para := range strings.Split(s, "\n") {
+		if width <= 0 {
+			lines = append(lines, para)
+			continue
+		}
+		line, n := "", 0
+		for _, word := range strings.Fields(para) {
+			wn := utf8.RuneCountInString(word)
+			if n > 0 && n+1+wn > width {
+				lines = append(lines, line)
+				line, n = "", 0
+			}
+			if n > 0 {
+				line += " "
+				n++
+			}
+			line += word
+			n += wn
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// indent prefixes the first line with first and the others with rest.
+func indent(lines []string, first, rest string) []string {
+	for i, line := range lines {
+		p := rest
+		if i == 0 {
+			p = first
+		}
+		if line == "" {
+			lines[i] = strings.TrimRight(p, " ")
+		} else {
+			lines[i] = p + line
+		}
+	}
+	return lines
+}
+
+// children iterates over the children of n, looking through fragments and
+// building lazy, deferred and error-boundary content. It stops once t.err is
+// set.
+func (t *textRenderer) children(n *h.Node) func(yield func(*h.Node) bool) {
+	return func(yield func(*h.Node) bool) {
+		var each func(n *h.Node) bool
+		each = func(n *h.Node) bool {
+			for c := n.FirstChild; c != nil; c = c.NextSibling {
+				if t.err == nil {
+					t.err = t.ctx.Err()
+				}
+				if t.err != nil {
+					return false
+				}
+				if r := t.resolve(c); r == nil {
+					continue
+				} else if r.Type == h.DocumentNode {
+					if !each(r) {
+						return false
+					}
+				} else if !yield(r) {
+					return false
+				}
+			}
+			return true
+		}
+		each(n)
+	}
+}
+
+// resolve returns the content of a dynamic node, or n itself for ordinary
+// nodes. A builder that panics sets t.err.
+func (t *textRenderer) resolve(n *h.Node) *h.Node {
+	var c *h.Node
+	switch n.Type {
+	case flushNode:
+	case lazyNode:
+		if build, ok := payload(n).(func(context.Context) *h.Node); ok {
+			t.err = recovered(func() error {
+				c = build(t.ctx)
+				return nil
+			})
+		}
+	case deferredNode:
+		if d, ok := payload(n).(*deferred); ok {
+			t.err = recovered(func() error {
+				c = d.produce(t.ctx)
+				return nil
+			})
+		}
+	case tryNode:
+		if b, ok := payload(n).(*boundary); ok {
+			c = Try(func() (*h.Node, error) { return b.build(t.ctx) }, b.fallback)
+		}
+	default:
+		return n
+	}
+	return c
+}
+
+// textContent returns the concatenated text of all descendants of n.
+func (t *textRenderer) textContent(n *h.Node) string {
+	var b strings.Builder
+	var walk func(n *h.Node)
+	walk = func(n *h.Node) {
+		for c := range t.children(n) {
+			switch {
+			case c.Type == h.TextNode:
+				b.WriteString(c.Data)
+			case c.Type == h.RawNode:
+				walk(parseRaw(c.Data))
+			case c.Type == h.ElementNode && c.DataAtom == a.Br:
+				b.WriteByte('\n')
+			case c.Type == h.ElementNode && !skipText[c.DataAtom]:
+				walk(c)
+			}
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+// parseRaw parses the HTML in a Raw node into a fragment.
+func parseRaw(s string) *h.Node {
+	frag := &h.Node{Type: h.DocumentNode}
+	nodes, err := h.ParseFragment(strings.NewReader(s), &h.Node{Type: h.ElementNode, DataAtom: a.Body, Data: "body"})
+	if err != nil {
+		return frag
+	}
+	for _, n := range nodes {
+		frag.AppendChild(n)
+	}
+	return frag
+}
+
+func isASCIISpace(r rune) bool {
+	return strings.ContainsRune(asciiSpace, r)
+}
+
+// orDefault returns width, or fallback if width is not positive.
+func orDefault(width, fallback int) int {
+	if width > 0 {
+		return width
+	}
+	return fallback
+}
+
+// skipText are the elements whose content is never shown as text.
+var skipText = map[a.Atom]bool{
+	a.Head: true, a.Script: true, a.Style: true, a.Template: true,
+	a.Noscript: true, a.Title: true, a.Meta: true, a.Link: true,
+	a.Select: true, a.Datalist: true, a.Object: true, a.Iframe: true,
+}
+
+// textBlocks are the elements laid out as separate blocks of text.
+var textBlocks = map[a.Atom]bool{
+	a.Address: true, a.Article: true, a.Aside: true, a.Blockquote: true,
+	a.Body: true, a.Caption: true, a.Details: true, a.Dialog: true,
+	a.Div: true, a.Dl: true, a.Fieldset: true, a.Figcaption: true,
+	a.Figure: true, a.Footer: true, a.Form: true, a.H1: true, a.H2: true,
+	a.H3: true, a.H4: true, a.H5: true, a.H6: true, a.Header: true,
+	a.Hgroup: true, a.Hr: true, a.Html: true, a.Legend: true, a.Li: true,
+	a.Main: true, a.Menu: true, a.Nav: true, a.Ol: true, a.P: true,
+	a.Pre: true, a.Section: true, a.Summary: true, a.Table: true, a.Ul: true,
+}
@@ -0,0 +1,83 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestRenderText(t *testing.T) {
+	email := Document(
+		Html(
+			Head(Title(Text("Receipt")), Style(Text("p { color: red }"))),
+			Body(
+				H1(Text("Your order")),
+				P(Text("Hello "), B(Text("Alice")), Text(", thanks for your order. It will be shipped within two working days.")),
+				P(Text("View "), A(Href("https://shop.example/o/1"), Text("your order")), Br(), Text("Questions? Just reply.")),
+				Ul(Li(Text("Free returns")), Li(Text("Tracking included"))),
+				Table(
+					Thead(Tr(Th(Text("Item")), Th(Text("Qty")))),
+					Tbody(Tr(Td(Text("Widget")), Td(Text("2"))), Tr(Td(Text("Large gadget")), Td(Text("10")))),
+				),
+				Script(Text("track()")),
+			),
+		),
+	)
+	want := `Your order
+==========
+
+Hello Alice, thanks for your order. It will be
+shipped within two working days.
+
+View your order (https://shop.example/o/1)
+Questions? Just reply.
+
+* Free returns
+* Tracking included
+
+Item          Qty
+------------  ---
+Widget        2
+Large gadget  10
+`
+
+	var buf bytes.Buffer
+	if err := RenderText(context.Background(), &buf, email, WithWidth(50)); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != want {
+		t.Errorf("got:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestRenderTextBlockInLink(t *testing.T) {
+	var buf bytes.Buffer
+	page := Div(P(Text("see "), A(Href("/x"), Div(Text("y")))), P(A(Href("/y"), P(Text("long")), Text("z"))))
+	if err := RenderText(context.Background(), &buf, page); err != nil {
+		t.Fatal(err)
+	}
+	if want := "see\n\ny\n\n(/x)\n\nlong\n\nz (/y)\n"; buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+}
+
+func TestRenderTextErrors(t *testing.T) {
+	var buf bytes.Buffer
+	page := Div(P(Text("a")), Lazy(func(ctx context.Context) *html.Node { panic("boom") }))
+	var perr *PanicError
+	if err := RenderText(context.Background(), &buf, page); !errors.As(err, &perr) || perr.Value != "boom" {
+		t.Errorf("got error %v, want the panic", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := RenderText(ctx, &buf, page); !errors.Is(err, context.Canceled) {
+		t.Errorf("got error %v, want %v", err, context.Canceled)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("wrote %q after an error", buf.String())
+	}
+}
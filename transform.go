@@ -0,0 +1,105 @@
+package ht
+
+import (
+	"strings"
+
+	h "golang.org/x/net/html"
+)
+
+// A Visitor rewrites nodes as they are written by Render. It is called with a
+// private shallow copy of each element, text, comment, raw and doctype node,
+// whose Data, Namespace and Attr it may change freely; the children are
+// shared with the original tree and must not be modified.
+//
+// Returning the copy writes it with any changes. Returning nil skips the node
+// and its subtree. Returning a different node writes that node instead, and it
+// is passed on to the remaining visitors in the chain.
+//
+// Because visitors never touch the tree passed to Render, cached and Static
+// subtrees remain safe to share. Note that Static content reaches visitors as
+// a single RawNode.
+type Visitor func(n *h.Node) *h.Node
+
+// WithVisitors runs every node through visitors, in order, as it is written.
+func WithVisitors(visitors ...Visitor) RenderOption {
+	return func(r *renderer) {
+		r.visitors = append(r.visitors, visitors...)
+	}
+}
+
+// visit runs n through the visitor chain and returns the node to write, or
+// nil if it should be skipped.
+func (r *renderer) visit(n *h.Node) *h.Node {
+	switch n.Type {
+	case h.ElementNode, h.TextNode, h.CommentNode, h.RawNode, h.DoctypeNode:
+	default:
+		return n
+	}
+	var own *h.Node
+	for _, v := range r.visitors {
+		if n != own {
+			c := *n
+			c.Attr = append([]h.Attribute(nil), n.Attr...)
+			own, n = &c, &c
+		}
+		if n = v(n); n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// AddNonce returns a Visitor that sets the nonce attribute on every Script
+// and Style element, so that inline code passes a Content-Security-Policy
+// that uses nonces.
+func AddNonce(nonce string) Visitor {
+	return func(n *h.Node) *h.Node {
+		if n.Type == h.ElementNode && n.Namespace == "" && (n.Data == "script" || n.Data == "style") {
+			Apply(n, Attr("nonce", nonce))
+		}
+		return n
+	}
+}
+
+// StripAttrs returns a Visitor that removes every attribute whose name starts
+// with one of the given prefixes, e.g. StripAttrs("data-test") in production.
+func StripAttrs(prefixes ...string) Visitor {
+	return func(n *h.Node) *h.Node {
+		attrs := n.Attr[:0]
+		for _, attr := range n.Attr {
+			strip := false
+			for _, p := range prefixes {
+				if strings.HasPrefix(attr.Key, p) {
+					strip = true
+					break
+				}
+			}
+			if !strip {
+				attrs = append(attrs, attr)
+			}
+		}
+		n.Attr = attrs
+		return n
+	}
+}
+
+// urlAttrs are the attributes whose values PrefixURLs rewrites.
+var urlAttrs = map[string]bool{
+	"action": true, "formaction": true, "href": true, "poster": true, "src": true,
+	"hx-delete": true, "hx-get": true, "hx-patch": true, "hx-post": true, "hx-put": true,
+}
+
+// PrefixURLs returns a Visitor that prepends prefix to root-relative URLs
+// ("/path", but not "//host/path") in link, source, form and htmx request
+// attributes, for applications mounted below the root of a site.
+func PrefixURLs(prefix string) Visitor {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return func(n *h.Node) *h.Node {
+		for i, attr := range n.Attr {
+			if attr.Namespace == "" && urlAttrs[attr.Key] && strings.HasPrefix(attr.Val, "/") && !strings.HasPrefix(attr.Val, "//") {
+				n.Attr[i].Val = prefix + attr.Val
+			}
+		}
+		return n
+	}
+}
@@ -0,0 +1,44 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestRenderVisitors(t *testing.T) {
+	page := Div(
+		Data("test", "root"),
+		Script(Src("/app.js")),
+		A(Href("/home"), Data("test", "link"), Text("Home")),
+		A(Href("//cdn.example.com/x"), Text("CDN")),
+		Comment("internal"),
+	)
+	dropComments := func(n *html.Node) *html.Node {
+		if n.Type == html.CommentNode {
+			return nil
+		}
+		return n
+	}
+
+	var buf bytes.Buffer
+	err := Render(context.Background(), &buf, page,
+		WithVisitors(AddNonce("r4nd0m"), StripAttrs("data-test"), PrefixURLs("/app/"), dropComments))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<div><script src="/app/app.js" nonce="r4nd0m"></script><a href="/app/home">Home</a><a href="//cdn.example.com/x">CDN</a></div>`
+	if stripSources(buf.String()) != want {
+		t.Errorf("got  %s\nwant %s", stripSources(buf.String()), want)
+	}
+
+	buf.Reset()
+	if err := Render(context.Background(), &buf, page); err != nil {
+		t.Fatal(err)
+	}
+	if want := `<div data-test="root"><script src="/app.js"></script><a href="/home" data-test="link">Home</a><a href="//cdn.example.com/x">CDN</a><!--internal--></div>`; stripSources(buf.String()) != want {
+		t.Errorf("visitors modified the tree: %s", stripSources(buf.String()))
+	}
+}
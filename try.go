@@ -0,0 +1,138 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	h "golang.org/x/net/html"
+)
+
+// OnError is called with every error and recovered panic caught by Try and
+// TryLazy, before the fallback is built, with panics in Deferred producers,
+// and with errors from Static subtrees that fail to render. The context is
+// the render context for TryLazy and Deferred and context.Background()
+// otherwise. It is nil by default; set it once at startup to report failures,
+// for example:
+//
+//	ht.OnError = func(ctx context.Context, err error) {
+//		slog.ErrorContext(ctx, "component failed", "err", err)
+//	}
+var OnError func(ctx context.Context, err error)
+
+// PanicError is the error Try and TryLazy report when a component panics.
+type PanicError struct {
+	Value any    // the value passed to panic
+	Stack []byte // the stack of the panicking goroutine
+}
+
+func (e *PanicError) Error() string { return fmt.Sprintf("ht: component panicked: %v", e.Value) }
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Try is an error boundary for eagerly built components. It returns the node
+// built by build, or, if build returns an error or panics, the node fallback
+// builds from that error. Either way the rest of the page is unaffected.
+// A nil fallback renders nothing in place of the failed component.
+func Try(build func() (*h.Node, error), fallback func(error) *h.Node) *h.Node {
+	var n *h.Node
+	err := recovered(func() (err error) {
+		n, err = build()
+		return err
+	})
+	if err == nil {
+		return n
+	}
+	return fail(context.Background(), err, fallback)
+}
+
+// TryLazy is an error boundary that is checked at render time. Like Lazy, build
+// is only called when Render reaches the node. Its result is rendered into a
+// buffer first, so errors and panics from the component or any lazy content
+// inside it are caught as well, and only the fallback is written in its place.
+//
+// Cancellation of the render context and exceeded render limits are not
+// treated as failures: they still stop the whole render.
+func TryLazy(build func(ctx context.Context) (*h.Node, error), fallback func(error) *h.Node) *h.Node {
+	return dynamicNode(tryNode, "ht:try", &boundary{build: build, fallback: fallback})
+}
+
+// boundary is the payload of a TryLazy node.
+type boundary struct {
+	build    func(ctx context.Context) (*h.Node, error)
+	fallback func(error) *h.Node
+}
+
+// try renders a TryLazy node.
+func (r *renderer) try(n *h.Node, depth int) error {
+	b, _ := payload(n).(*boundary)
+	if b == nil {
+		return nil
+	}
+
+	// Deferred producers started inside the boundary run under their own
+	// context, so that they can be stopped if it fails.
+	r.startAsync()
+	var buf bytes.Buffer
+	sub := *r
+	sub.w, sub.buf, sub.out = &buf, nil, nil
+	sub.async, sub.stopAsync = context.WithCancel(r.async)
+	if lw, ok := r.w.(*limitWriter); ok {
+		sub.w = &limitWriter{w: &buf, max: lw.max - lw.n}
+	}
+	err := recovered(func() error {
+		c, err := b.build(r.ctx)
+		if err != nil || c == nil {
+			return err
+		}
+		return sub.node(c, depth)
+	})
+
+	if err == nil || err == errPlaintext {
+		r.pending, r.fresh, r.nodes = sub.pending, sub.fresh, sub.nodes
+		if _, werr := r.w.Write(buf.Bytes()); werr != nil {
+			return werr
+		}
+		return err
+	}
+	// Nothing from the boundary is written, so its Deferred placeholders are
+	// dropped along with their producers.
+	sub.stopAsync()
+	if r.ctx.Err() != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return err
+	}
+	if errors.As(err, new(*LimitError)) {
+		return err
+	}
+	if fb := fail(r.ctx, err, b.fallback); fb != nil {
+		return r.node(fb, depth)
+	}
+	return nil
+}
+
+// fail reports err to OnError and builds the fallback for it.
+func fail(ctx context.Context, err error, fallback func(error) *h.Node) *h.Node {
+	if OnError != nil {
+		OnError(ctx, err)
+	}
+	if fallback == nil {
+		return nil
+	}
+	return fallback(err)
+}
+
+// recovered calls f, turning a panic into a *PanicError.
+func recovered(f func() error) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			err = &PanicError{Value: v, Stack: debug.Stack()}
+		}
+	}()
+	return f()
+}
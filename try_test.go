@@ -0,0 +1,72 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestTry(t *testing.T) {
+	var reported []error
+	OnError = func(ctx context.Context, err error) { reported = append(reported, err) }
+	defer func() { OnError = nil }()
+
+	fallback := func(err error) *html.Node { return P(Class("error"), Text("unavailable")) }
+	page := Div(
+		Try(func() (*html.Node, error) { return P(Text("ok")), nil }, fallback),
+		Try(func() (*html.Node, error) { return nil, errors.New("failed") }, fallback),
+		TryLazy(func(ctx context.Context) (*html.Node, error) {
+			return P(Text("partial "), Lazy(func(ctx context.Context) *html.Node { panic("boom") })), nil
+		}, fallback),
+	)
+
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, page); err != nil {
+		t.Fatal(err)
+	}
+	want := `<div><p>ok</p><p class="error">unavailable</p><p class="error">unavailable</p></div>`
+	if stripSources(buf.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(buf.String()), want)
+	}
+
+	var perr *PanicError
+	if len(reported) != 2 || !errors.As(reported[1], &perr) || perr.Value != "boom" {
+		t.Errorf("reported %v, want an error and a panic", reported)
+	}
+
+	limited := TryLazy(func(ctx context.Context) (*html.Node, error) {
+		return nil, fmt.Errorf("loading: %w", &LimitError{Limit: "nodes", Max: 1})
+	}, fallback)
+	if err := Render(context.Background(), &bytes.Buffer{}, limited); !errors.As(err, new(*LimitError)) {
+		t.Errorf("got error %v, want the wrapped LimitError", err)
+	}
+}
+
+func TestTryLazyDiscardsFailedWork(t *testing.T) {
+	started := make(chan struct{})
+	stopped := make(chan struct{})
+	page := Div(TryLazy(func(ctx context.Context) (*html.Node, error) {
+		return Div(
+			Deferred(func(ctx context.Context) *html.Node {
+				close(started)
+				<-ctx.Done()
+				close(stopped)
+				return P(Text("late"))
+			}, Text("loading")),
+			Lazy(func(ctx context.Context) *html.Node { <-started; panic("boom") }),
+		), nil
+	}, func(error) *html.Node { return P(Text("unavailable")) }))
+
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, page, WithMaxNodes(8)); err != nil {
+		t.Fatal(err)
+	}
+	if want := "<div><p>unavailable</p></div>"; stripSources(buf.String()) != want {
+		t.Errorf("got %q, want %q", stripSources(buf.String()), want)
+	}
+	<-stopped
+}
@@ -0,0 +1,214 @@
+package ht
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	h "golang.org/x/net/html"
+)
+
+// WithXML serializes the tree as well-formed XML, for XHTML documents, EPUB
+// content, SVG files and markup embedded in feeds:
+//
+//   - boolean attributes are written in full, e.g. disabled="disabled";
+//   - the XHTML, SVG and MathML namespaces are declared with xmlns wherever
+//     an element's namespace differs from its parent's, and xmlns:xlink
+//     wherever xlink attributes are first used;
+//   - the content of Script and Style is wrapped in a CDATA section;
+//   - text is always escaped, including inside Noscript and Iframe.
+//
+// Rendering fails with an error for anything that cannot be expressed in XML,
+// such as attribute names like Alpine's "@click" shorthand, duplicate
+// attributes, comments containing "--", or characters XML does not allow.
+// Raw nodes are written unchanged and must already be well-formed.
+//
+// XML takes precedence over WithMinify.
+func WithXML() RenderOption {
+	return func(r *renderer) {
+		r.xml = true
+	}
+}
+
+// Namespace URIs for the namespaces html.Node uses.
+const (
+	xhtmlNS = "http://www.w3.org/1999/xhtml"
+	svgNS   = "http://www.w3.org/2000/svg"
+	mathNS  = "http://www.w3.org/1998/Math/MathML"
+	xlinkNS = "http://www.w3.org/1999/xlink"
+)
+
+// xmlScope is the namespace state inherited from ancestors.
+type xmlScope struct {
+	ns       string   // the default namespace in scope
+	prefixes []string // the namespace prefixes declared by ancestors
+}
+
+// xmlAttrs writes the attributes of n, adding the namespace declarations the
+// element needs, and updates r.scope for its children.
+func (r *renderer) xmlAttrs(n *h.Node) error {
+	if !xmlName(n.Data) {
+		return fmt.Errorf("ht: element name %q is not valid in XML", n.Data)
+	}
+
+	ns, err := namespaceURI(n.Namespace)
+	if err != nil {
+		return err
+	}
+	if v, ok := lookupAttr(n, "", "xmlns"); ok {
+		ns = v
+	} else if ns != r.scope.ns {
+		if err := r.attr(h.Attribute{Key: "xmlns", Val: ns}); err != nil {
+			return err
+		}
+	}
+	r.scope.ns = ns
+
+	seen := make(map[string]bool, len(n.Attr))
+	for _, attr := range n.Attr {
+		name := attr.Key
+		if attr.Namespace != "" {
+			name = attr.Namespace + ":" + attr.Key
+		}
+		if seen[name] {
+			return fmt.Errorf("ht: duplicate attribute %q on <%s>", name, n.Data)
+		}
+		seen[name] = true
+
+		prefix, local, ok := strings.Cut(name, ":")
+		if !ok {
+			prefix, local = "", name
+		}
+		if !xmlName(local) || (prefix != "" && !xmlName(prefix)) {
+			return fmt.Errorf("ht: attribute name %q on <%s> is not valid in XML", name, n.Data)
+		}
+		switch {
+		case prefix == "" || prefix == "xml" || prefix == "xmlns":
+		case slices.Contains(r.scope.prefixes, prefix) || declaresPrefix(n, prefix):
+		case prefix == "xlink":
+			if err := r.attr(h.Attribute{Namespace: "xmlns", Key: "xlink", Val: xlinkNS}); err != nil {
+				return err
+			}
+			r.scope.prefixes = append(slices.Clip(r.scope.prefixes), "xlink")
+		default:
+			return fmt.Errorf("ht: attribute %q on <%s> uses an undeclared namespace prefix", name, n.Data)
+		}
+		if prefix == "xmlns" {
+			// Clip so that the ancestors' scope, saved by element, is kept.
+			r.scope.prefixes = append(slices.Clip(r.scope.prefixes), local)
+		}
+
+		if attr.Val == "" && attr.Namespace == "" && booleanAttrs[attr.Key] {
+			attr.Val = attr.Key
+		}
+		if err := checkXMLChars(attr.Val); err != nil {
+			return err
+		}
+		if err := r.attr(attr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// xmlLiteral writes the children of an element whose text is literal in
+// HTML. Script and Style content goes into a CDATA section; everything else
+// is escaped as usual.
+func (r *renderer) xmlLiteral(n *h.Node, depth int) error {
+	if n.Data != "script" && n.Data != "style" {
+		return r.children(n, depth+1, false)
+	}
+	if n.FirstChild == nil {
+		return nil
+	}
+	if _, err := r.w.WriteString("<![CDATA["); err != nil {
+		return err
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != h.TextNode && c.Type != h.RawNode {
+			return fmt.Errorf("ht: <%s> may only contain text in XML", n.Data)
+		}
+		if err := checkXMLChars(c.Data); err != nil {
+			return err
+		}
+		// "]]>" would end the section early, so split it across two.
+		if _, err := r.w.WriteString(strings.ReplaceAll(c.Data, "]]>", "]]]]><![CDATA[>")); err != nil {
+			return err
+		}
+	}
+	_, err := r.w.WriteString("]]>")
+	return err
+}
+
+// xmlComment checks that a comment can be written in XML.
+func xmlComment(n *h.Node) error {
+	if strings.Contains(n.Data, "--") || strings.HasSuffix(n.Data, "-") {
+		return fmt.Errorf("ht: comment %q is not valid in XML", n.Data)
+	}
+	return checkXMLChars(n.Data)
+}
+
+// namespaceURI maps an html.Node namespace to its URI.
+func namespaceURI(ns string) (string, error) {
+	switch ns {
+	case "":
+		return xhtmlNS, nil
+	case "svg":
+		return svgNS, nil
+	case "math":
+		return mathNS, nil
+	}
+	return "", fmt.Errorf("ht: unknown element namespace %q", ns)
+}
+
+// lookupAttr looks up an attribute of n by namespace and key.
+func lookupAttr(n *h.Node, namespace, key string) (string, bool) {
+	for _, attr := range n.Attr {
+		if attr.Namespace == namespace && attr.Key == key {
+			return attr.Val, true
+		}
+	}
+	return "", false
+}
+
+// declaresPrefix reports whether n declares the namespace prefix itself, with
+// an xmlns:prefix attribute.
+func declaresPrefix(n *h.Node, prefix string) bool {
+	for _, attr := range n.Attr {
+		if attr.Namespace == "xmlns" && attr.Key == prefix || attr.Namespace == "" && attr.Key == "xmlns:"+prefix {
+			return true
+		}
+	}
+	return false
+}
+
+// checkXMLChars reports an error if s contains a character that is not
+// allowed anywhere in an XML 1.0 document.
+func checkXMLChars(s string) error {
+	if !utf8.ValidString(s) {
+		return fmt.Errorf("ht: invalid UTF-8 in %q", s)
+	}
+	for _, c := range s {
+		if c < 0x20 && c != '\t' && c != '\n' && c != '\r' || c == 0xFFFE || c == 0xFFFF {
+			return fmt.Errorf("ht: character %U is not allowed in XML", c)
+		}
+	}
+	return nil
+}
+
+// xmlName reports whether s is a valid XML name without a namespace prefix.
+func xmlName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', c >= 0xC0 && c != 0xD7 && c != 0xF7:
+		case i > 0 && (c == '-' || c == '.' || '0' <= c && c <= '9' || c == 0xB7):
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+package ht
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+)
+
+func TestRenderXML(t *testing.T) {
+	svg := &html.Node{Type: html.ElementNode, Data: "svg", Namespace: "svg"}
+	use := &html.Node{Type: html.ElementNode, Data: "use", Namespace: "svg",
+		Attr: []html.Attribute{{Namespace: "xlink", Key: "href", Val: "#icon"}}}
+	svg.AppendChild(use)
+
+	page := Html(
+		Body(
+			Input(Type("checkbox"), Checked()),
+			Script(Raw("if (a < b && c) { x = ']]>' }")),
+			svg,
+			P(Text("a & b")),
+		),
+	)
+
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, page, WithXML()); err != nil {
+		t.Fatal(err)
+	}
+	want := `<html xmlns="http://www.w3.org/1999/xhtml"><body>` +
+		`<input type="checkbox" checked="checked"/>` +
+		`<script><![CDATA[if (a < b && c) { x = ']]]]><![CDATA[>' }]]></script>` +
+		`<svg xmlns="http://www.w3.org/2000/svg"><use xmlns:xlink="http://www.w3.org/1999/xlink" xlink:href="#icon"></use></svg>` +
+		`<p>a &amp; b</p></body></html>`
+	if stripSources(buf.String()) != want {
+		t.Errorf("got  %s\nwant %s", stripSources(buf.String()), want)
+	}
+
+	for _, bad := range []*html.Node{
+		Div(XOn("click", "open = true")),
+		Comment("a -- b"),
+		P(Text("bell \a")),
+	} {
+		if err := Render(context.Background(), &bytes.Buffer{}, bad, WithXML()); err == nil {
+			t.Errorf("rendering %v as XML succeeded, want an error", bad)
+		}
+	}
+}
+
+func TestBooleanAttrsXML(t *testing.T) {
+	n := Dialog(Open(), Inert(), Popover("manual"), Script(Nomodule(), Async(), Src("/a.js")))
+	want := `<dialog xmlns="http://www.w3.org/1999/xhtml" open="open" inert="inert" popover="manual">` +
+		`<script nomodule="nomodule" async="async" src="/a.js"></script></dialog>`
+	var b strings.Builder
+	if err := Render(context.Background(), &b, n, WithXML()); err != nil {
+		t.Fatal(err)
+	}
+	if stripSources(b.String()) != want {
+		t.Errorf("got:\n%s\nwant:\n%s", stripSources(b.String()), want)
+	}
+}
+
+func TestRenderXMLPrefixes(t *testing.T) {
+	svg := &html.Node{Type: html.ElementNode, Data: "svg", Namespace: "svg", Attr: []html.Attribute{
+		{Namespace: "xmlns", Key: "ex", Val: "urn:example"},
+	}}
+	use := &html.Node{Type: html.ElementNode, Data: "use", Namespace: "svg", Attr: []html.Attribute{
+		{Namespace: "xlink", Key: "href", Val: "#icon"},
+		{Namespace: "xmlns", Key: "xlink", Val: "http://www.w3.org/1999/xlink"},
+		{Namespace: "ex", Key: "id", Val: "1"},
+	}}
+	svg.AppendChild(use)
+
+	var buf bytes.Buffer
+	if err := Render(context.Background(), &buf, svg, WithXML()); err != nil {
+		t.Fatal(err)
+	}
+	want := `<svg xmlns="http://www.w3.org/2000/svg" xmlns:ex="urn:example">` +
+		`<use xlink:href="#icon" xmlns:xlink="http://www.w3.org/1999/xlink" ex:id="1"></use></svg>`
+	if stripSources(buf.String()) != want {
+		t.Errorf("got  %s\nwant %s", stripSources(buf.String()), want)
+	}
+}